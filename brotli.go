@@ -0,0 +1,910 @@
+package traefiklogger
+
+import (
+	"errors"
+)
+
+// Brotli decompressor written after RFC 7932 (https://www.rfc-editor.org/rfc/rfc7932).
+// It works on the whole compressed body at once, which is what the logger has anyway.
+
+var errBrotliCorrupt = errors.New("brotli: corrupt input")
+
+const (
+	brotliLiteralAlphabetSize    = 256
+	brotliCommandAlphabetSize    = 704
+	brotliBlockCountAlphabetSize = 26
+	brotliMaxCodeLength          = 15
+	brotliNumTransforms          = 121
+)
+
+var brotliCodeLengthCodeOrder = [18]int{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+var brotliBlockCountBase = [brotliBlockCountAlphabetSize]int{
+	1, 5, 9, 13, 17, 25, 33, 41, 49, 65, 81, 97, 113, 145, 177, 209, 241, 305, 369, 497, 753, 1265, 2289, 4337, 8433, 16625,
+}
+
+var brotliBlockCountExtra = [brotliBlockCountAlphabetSize]uint{
+	2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 6, 6, 7, 8, 9, 10, 11, 12, 13, 24,
+}
+
+var brotliInsertLengthBase = [24]int{
+	0, 1, 2, 3, 4, 5, 6, 8, 10, 14, 18, 26, 34, 50, 66, 98, 130, 194, 322, 578, 1090, 2114, 6210, 22594,
+}
+
+var brotliInsertLengthExtra = [24]uint{
+	0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 12, 14, 24,
+}
+
+var brotliCopyLengthBase = [24]int{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 18, 22, 30, 38, 54, 70, 102, 134, 198, 326, 582, 1094, 2118,
+}
+
+var brotliCopyLengthExtra = [24]uint{
+	0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 24,
+}
+
+// brotliCommandCells maps the upper bits of an insert-and-copy command to the insert and copy length code offsets.
+var brotliCommandCells = [11]struct {
+	insert       int
+	copy         int
+	implicitDist bool
+}{
+	{0, 0, true}, {0, 8, true}, {0, 0, false}, {0, 8, false}, {8, 0, false}, {8, 8, false},
+	{0, 16, false}, {16, 0, false}, {8, 16, false}, {16, 8, false}, {16, 16, false},
+}
+
+// brotliDistanceShortCodes lists the ring buffer index and the delta of distance codes 0-15.
+var brotliDistanceShortCodes = [16]struct {
+	index int
+	delta int
+}{
+	{0, 0}, {1, 0}, {2, 0}, {3, 0},
+	{0, -1}, {0, 1}, {0, -2}, {0, 2}, {0, -3}, {0, 3},
+	{1, -1}, {1, 1}, {1, -2}, {1, 2}, {1, -3}, {1, 3},
+}
+
+// Context lookup tables of the UTF8 context mode (Lut0 for p1 and Lut1 for p2).
+var brotliContextLut0 = [256]uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 4, 0, 0, 4, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	8, 12, 16, 12, 12, 20, 12, 16, 24, 28, 12, 12, 32, 12, 36, 12,
+	44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 32, 32, 24, 40, 28, 12,
+	12, 48, 52, 52, 52, 48, 52, 52, 52, 48, 52, 52, 52, 52, 52, 48,
+	52, 52, 52, 52, 52, 48, 52, 52, 52, 52, 52, 24, 12, 28, 12, 12,
+	12, 56, 60, 60, 60, 56, 60, 60, 60, 56, 60, 60, 60, 60, 60, 56,
+	60, 60, 60, 60, 60, 56, 60, 60, 60, 60, 60, 24, 12, 28, 12, 0,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
+}
+var brotliContextLut1 = [256]uint8{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 1, 1, 1, 1, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+}
+
+// brotliLut2 is the context lookup table of the signed context mode.
+func brotliLut2(b byte) byte {
+	switch {
+	case b == 0:
+		return 0
+	case b < 16:
+		return 1
+	case b < 64:
+		return 2
+	case b < 128:
+		return 3
+	case b < 192:
+		return 4
+	case b < 240:
+		return 5
+	case b < 255:
+		return 6
+	default:
+		return 7
+	}
+}
+
+func brotliLiteralContext(mode int, p1, p2 byte) int {
+	switch mode {
+	case 0: // LSB6
+		return int(p1 & 0x3f)
+	case 1: // MSB6
+		return int(p1 >> 2)
+	case 2: // UTF8
+		return int(brotliContextLut0[p1] | brotliContextLut1[p2])
+	default: // Signed
+		return int(brotliLut2(p1)<<3 | brotliLut2(p2))
+	}
+}
+
+// brotliBitReader reads the input from the least significant bit of each byte.
+type brotliBitReader struct {
+	data   []byte
+	bitPos int
+}
+
+func (br *brotliBitReader) readBits(n uint) (int, error) {
+	value := 0
+	for i := uint(0); i < n; i++ {
+		bytePos := br.bitPos >> 3
+		if bytePos >= len(br.data) {
+			return 0, errBrotliCorrupt
+		}
+		value |= int((br.data[bytePos]>>(br.bitPos&7))&1) << i
+		br.bitPos++
+	}
+	return value, nil
+}
+
+// alignToByte skips the padding bits which must be zero.
+func (br *brotliBitReader) alignToByte() error {
+	if rem := br.bitPos & 7; rem != 0 {
+		padding, err := br.readBits(uint(8 - rem))
+		if err != nil {
+			return err
+		}
+		if padding != 0 {
+			return errBrotliCorrupt
+		}
+	}
+	return nil
+}
+
+// brotliHuffman is a canonical prefix code.
+type brotliHuffman struct {
+	counts  [brotliMaxCodeLength + 1]int
+	symbols []int
+	single  bool
+}
+
+func newBrotliHuffman(lengths []int) *brotliHuffman {
+	h := &brotliHuffman{}
+	used := 0
+	for _, length := range lengths {
+		h.counts[length]++
+		if length > 0 {
+			used++
+		}
+	}
+	offsets := [brotliMaxCodeLength + 2]int{}
+	for length := 1; length <= brotliMaxCodeLength; length++ {
+		offsets[length+1] = offsets[length] + h.counts[length]
+	}
+	h.symbols = make([]int, used)
+	for symbol, length := range lengths {
+		if length > 0 {
+			h.symbols[offsets[length]] = symbol
+			offsets[length]++
+		}
+	}
+	h.single = used == 1
+	return h
+}
+
+func (h *brotliHuffman) decode(br *brotliBitReader) (int, error) {
+	if h.single {
+		return h.symbols[0], nil
+	}
+	code, first, index := 0, 0, 0
+	for length := 1; length <= brotliMaxCodeLength; length++ {
+		bit, err := br.readBits(1)
+		if err != nil {
+			return 0, err
+		}
+		code |= bit
+		count := h.counts[length]
+		if code-first < count {
+			return h.symbols[index+code-first], nil
+		}
+		index += count
+		first = (first + count) << 1
+		code <<= 1
+	}
+	return 0, errBrotliCorrupt
+}
+
+func readBrotliPrefixCode(br *brotliBitReader, alphabetSize int) (*brotliHuffman, error) {
+	hskip, err := br.readBits(2)
+	if err != nil {
+		return nil, err
+	}
+	if hskip == 1 {
+		return readBrotliSimplePrefixCode(br, alphabetSize)
+	}
+	return readBrotliComplexPrefixCode(br, alphabetSize, hskip)
+}
+
+func readBrotliSimplePrefixCode(br *brotliBitReader, alphabetSize int) (*brotliHuffman, error) {
+	nsym, err := br.readBits(2)
+	if err != nil {
+		return nil, err
+	}
+	nsym++
+	alphabetBits := uint(0)
+	for (1 << alphabetBits) < alphabetSize {
+		alphabetBits++
+	}
+	symbols := make([]int, nsym)
+	for i := range symbols {
+		symbols[i], err = br.readBits(alphabetBits)
+		if err != nil {
+			return nil, err
+		}
+		if symbols[i] >= alphabetSize {
+			return nil, errBrotliCorrupt
+		}
+		for j := 0; j < i; j++ {
+			if symbols[j] == symbols[i] {
+				return nil, errBrotliCorrupt
+			}
+		}
+	}
+	lengths := make([]int, alphabetSize)
+	switch nsym {
+	case 1:
+		return &brotliHuffman{symbols: symbols, single: true}, nil
+	case 2:
+		lengths[symbols[0]], lengths[symbols[1]] = 1, 1
+	case 3:
+		lengths[symbols[0]], lengths[symbols[1]], lengths[symbols[2]] = 1, 2, 2
+	default:
+		treeSelect, err := br.readBits(1)
+		if err != nil {
+			return nil, err
+		}
+		if treeSelect == 0 {
+			lengths[symbols[0]], lengths[symbols[1]], lengths[symbols[2]], lengths[symbols[3]] = 2, 2, 2, 2
+		} else {
+			lengths[symbols[0]], lengths[symbols[1]], lengths[symbols[2]], lengths[symbols[3]] = 1, 2, 3, 3
+		}
+	}
+	return newBrotliHuffman(lengths), nil
+}
+
+// readBrotliCodeLengthCodeLength reads the static prefix code of the code length code lengths.
+func readBrotliCodeLengthCodeLength(br *brotliBitReader) (int, error) {
+	prefix, err := br.readBits(2)
+	if err != nil {
+		return 0, err
+	}
+	switch prefix {
+	case 0:
+		return 0, nil
+	case 1:
+		return 4, nil
+	case 2:
+		return 3, nil
+	}
+	bit, err := br.readBits(1)
+	if err != nil || bit == 0 {
+		return 2, err
+	}
+	bit, err = br.readBits(1)
+	if err != nil || bit == 0 {
+		return 1, err
+	}
+	return 5, nil
+}
+
+func readBrotliComplexPrefixCode(br *brotliBitReader, alphabetSize, hskip int) (*brotliHuffman, error) {
+	codeLengthLengths := make([]int, len(brotliCodeLengthCodeOrder))
+	space, numCodes := 32, 0
+	for i := hskip; i < len(brotliCodeLengthCodeOrder) && space > 0; i++ {
+		length, err := readBrotliCodeLengthCodeLength(br)
+		if err != nil {
+			return nil, err
+		}
+		codeLengthLengths[brotliCodeLengthCodeOrder[i]] = length
+		if length != 0 {
+			space -= 32 >> length
+			numCodes++
+		}
+	}
+	if numCodes != 1 && space != 0 {
+		return nil, errBrotliCorrupt
+	}
+	codeLengthCode := newBrotliHuffman(codeLengthLengths)
+
+	lengths := make([]int, alphabetSize)
+	prevLength, repeat, repeatLength := 8, 0, 0
+	space = 1 << brotliMaxCodeLength
+	for symbol := 0; symbol < alphabetSize && space > 0; {
+		codeLength, err := codeLengthCode.decode(br)
+		if err != nil {
+			return nil, err
+		}
+		if codeLength < 16 {
+			repeat = 0
+			lengths[symbol] = codeLength
+			symbol++
+			if codeLength != 0 {
+				prevLength = codeLength
+				space -= (1 << brotliMaxCodeLength) >> codeLength
+			}
+			continue
+		}
+		extraBits, newLength := uint(2), prevLength
+		if codeLength == 17 {
+			extraBits, newLength = 3, 0
+		}
+		if repeatLength != newLength {
+			repeat, repeatLength = 0, newLength
+		}
+		oldRepeat := repeat
+		if repeat > 0 {
+			repeat = (repeat - 2) << extraBits
+		}
+		extra, err := br.readBits(extraBits)
+		if err != nil {
+			return nil, err
+		}
+		repeat += extra + 3
+		delta := repeat - oldRepeat
+		if symbol+delta > alphabetSize {
+			return nil, errBrotliCorrupt
+		}
+		for i := 0; i < delta; i++ {
+			lengths[symbol] = repeatLength
+			symbol++
+		}
+		if repeatLength != 0 {
+			space -= delta << (brotliMaxCodeLength - repeatLength)
+		}
+	}
+	if space != 0 {
+		return nil, errBrotliCorrupt
+	}
+	return newBrotliHuffman(lengths), nil
+}
+
+// readBrotliVarLenUint8 reads the variable length code of NBLTYPES and NTREES minus one.
+func readBrotliVarLenUint8(br *brotliBitReader) (int, error) {
+	bit, err := br.readBits(1)
+	if err != nil || bit == 0 {
+		return 0, err
+	}
+	n, err := br.readBits(3)
+	if err != nil || n == 0 {
+		return 1, err
+	}
+	extra, err := br.readBits(uint(n))
+	if err != nil {
+		return 0, err
+	}
+	return extra + (1 << n), nil
+}
+
+// brotliBlockSplit tracks the block types of a category (literal, insert-and-copy or distance).
+type brotliBlockSplit struct {
+	numTypes  int
+	typeCode  *brotliHuffman
+	countCode *brotliHuffman
+	current   int
+	previous  int
+	remaining int
+}
+
+func readBrotliBlockSplit(br *brotliBitReader) (*brotliBlockSplit, error) {
+	numTypes, err := readBrotliVarLenUint8(br)
+	if err != nil {
+		return nil, err
+	}
+	bs := &brotliBlockSplit{numTypes: numTypes + 1, previous: 1, remaining: 1 << 28}
+	if bs.numTypes < 2 {
+		return bs, nil
+	}
+	if bs.typeCode, err = readBrotliPrefixCode(br, bs.numTypes+2); err != nil {
+		return nil, err
+	}
+	if bs.countCode, err = readBrotliPrefixCode(br, brotliBlockCountAlphabetSize); err != nil {
+		return nil, err
+	}
+	bs.remaining, err = bs.readCount(br)
+	return bs, err
+}
+
+func (bs *brotliBlockSplit) readCount(br *brotliBitReader) (int, error) {
+	code, err := bs.countCode.decode(br)
+	if err != nil {
+		return 0, err
+	}
+	extra, err := br.readBits(brotliBlockCountExtra[code])
+	if err != nil {
+		return 0, err
+	}
+	return brotliBlockCountBase[code] + extra, nil
+}
+
+// next consumes one symbol of the category, switching the block type first if needed.
+func (bs *brotliBlockSplit) next(br *brotliBitReader) error {
+	if bs.remaining == 0 {
+		code, err := bs.typeCode.decode(br)
+		if err != nil {
+			return err
+		}
+		var blockType int
+		switch code {
+		case 0:
+			blockType = bs.previous
+		case 1:
+			blockType = (bs.current + 1) % bs.numTypes
+		default:
+			blockType = code - 2
+		}
+		if blockType >= bs.numTypes {
+			return errBrotliCorrupt
+		}
+		bs.previous, bs.current = bs.current, blockType
+		if bs.remaining, err = bs.readCount(br); err != nil {
+			return err
+		}
+	}
+	bs.remaining--
+	return nil
+}
+
+func readBrotliContextMap(br *brotliBitReader, size int) ([]int, int, error) {
+	numTrees, err := readBrotliVarLenUint8(br)
+	if err != nil {
+		return nil, 0, err
+	}
+	numTrees++
+	contextMap := make([]int, size)
+	if numTrees < 2 {
+		return contextMap, numTrees, nil
+	}
+	rleMax := 0
+	useRLE, err := br.readBits(1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if useRLE == 1 {
+		if rleMax, err = br.readBits(4); err != nil {
+			return nil, 0, err
+		}
+		rleMax++
+	}
+	code, err := readBrotliPrefixCode(br, numTrees+rleMax)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := 0; i < size; {
+		symbol, err := code.decode(br)
+		if err != nil {
+			return nil, 0, err
+		}
+		switch {
+		case symbol == 0:
+			i++
+		case symbol <= rleMax:
+			extra, err := br.readBits(uint(symbol))
+			if err != nil {
+				return nil, 0, err
+			}
+			i += (1 << symbol) + extra
+			if i > size {
+				return nil, 0, errBrotliCorrupt
+			}
+		default:
+			contextMap[i] = symbol - rleMax
+			i++
+		}
+	}
+	imtf, err := br.readBits(1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if imtf == 1 {
+		inverseMoveToFront(contextMap)
+	}
+	return contextMap, numTrees, nil
+}
+
+func inverseMoveToFront(values []int) {
+	mtf := make([]int, 256)
+	for i := range mtf {
+		mtf[i] = i
+	}
+	for i, index := range values {
+		value := mtf[index]
+		values[i] = value
+		copy(mtf[1:index+1], mtf[:index])
+		mtf[0] = value
+	}
+}
+
+func readBrotliPrefixCodes(br *brotliBitReader, count, alphabetSize int) ([]*brotliHuffman, error) {
+	codes := make([]*brotliHuffman, count)
+	for i := range codes {
+		code, err := readBrotliPrefixCode(br, alphabetSize)
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+	}
+	return codes, nil
+}
+
+// brotliDecoder holds the state that lives across meta-blocks.
+type brotliDecoder struct {
+	br        *brotliBitReader
+	out       []byte
+	limit     int
+	window    int
+	distances [4]int
+}
+
+// decodeBrotli decompresses a brotli stream.
+// With a positive limit, the output is cut at limit bytes and errDecodedBodyTooLarge is returned.
+func decodeBrotli(data []byte, limit int) ([]byte, error) {
+	d := &brotliDecoder{
+		br:        &brotliBitReader{data: data},
+		limit:     limit,
+		distances: [4]int{4, 11, 15, 16},
+	}
+	wbits, err := d.readWindowBits()
+	if err != nil {
+		return nil, err
+	}
+	d.window = (1 << wbits) - 16
+	for {
+		last, err := d.readMetaBlock()
+		if err != nil {
+			return d.out, err
+		}
+		if last {
+			return d.out, nil
+		}
+	}
+}
+
+func (d *brotliDecoder) readWindowBits() (int, error) {
+	bit, err := d.br.readBits(1)
+	if err != nil || bit == 0 {
+		return 16, err
+	}
+	n, err := d.br.readBits(3)
+	if err != nil || n != 0 {
+		return 17 + n, err
+	}
+	n, err = d.br.readBits(3)
+	if err != nil {
+		return 0, err
+	}
+	switch n {
+	case 0:
+		return 17, nil
+	case 1: // Large window brotli is not part of RFC 7932.
+		return 0, errBrotliCorrupt
+	default:
+		return 8 + n, nil
+	}
+}
+
+// readMetaBlock decodes a meta-block and returns true if it was the last one.
+func (d *brotliDecoder) readMetaBlock() (bool, error) {
+	br := d.br
+	last, err := br.readBits(1)
+	if err != nil {
+		return false, err
+	}
+	if last == 1 {
+		empty, err := br.readBits(1)
+		if err != nil || empty == 1 {
+			return true, err
+		}
+	}
+	length, err := d.readMetaBlockLength()
+	if err != nil || length == 0 {
+		return last == 1, err
+	}
+	if last == 0 {
+		uncompressed, err := br.readBits(1)
+		if err != nil {
+			return false, err
+		}
+		if uncompressed == 1 {
+			return false, d.copyUncompressed(length)
+		}
+	}
+	return last == 1, d.readCompressed(length)
+}
+
+// readMetaBlockLength returns MLEN, or zero after skipping a metadata block.
+func (d *brotliDecoder) readMetaBlockLength() (int, error) {
+	br := d.br
+	nibbles, err := br.readBits(2)
+	if err != nil {
+		return 0, err
+	}
+	if nibbles == 3 {
+		return 0, d.skipMetadata()
+	}
+	length, err := br.readBits(uint(nibbles+4) * 4)
+	if err != nil {
+		return 0, err
+	}
+	return length + 1, nil
+}
+
+func (d *brotliDecoder) skipMetadata() error {
+	br := d.br
+	reserved, err := br.readBits(1)
+	if err != nil {
+		return err
+	}
+	if reserved != 0 {
+		return errBrotliCorrupt
+	}
+	skipBytes, err := br.readBits(2)
+	if err != nil {
+		return err
+	}
+	skipLength := 0
+	if skipBytes > 0 {
+		if skipLength, err = br.readBits(uint(skipBytes) * 8); err != nil {
+			return err
+		}
+		skipLength++
+	}
+	if err := br.alignToByte(); err != nil {
+		return err
+	}
+	if br.bitPos/8+skipLength > len(br.data) {
+		return errBrotliCorrupt
+	}
+	br.bitPos += skipLength * 8
+	return nil
+}
+
+func (d *brotliDecoder) copyUncompressed(length int) error {
+	br := d.br
+	if err := br.alignToByte(); err != nil {
+		return err
+	}
+	start := br.bitPos / 8
+	if start+length > len(br.data) {
+		return errBrotliCorrupt
+	}
+	br.bitPos += length * 8
+	return d.write(br.data[start : start+length]...)
+}
+
+func (d *brotliDecoder) write(b ...byte) error {
+	if d.limit > 0 && len(d.out)+len(b) > d.limit {
+		d.out = append(d.out, b[:d.limit-len(d.out)]...)
+		return errDecodedBodyTooLarge
+	}
+	d.out = append(d.out, b...)
+	return nil
+}
+
+// brotliMetaBlock contains the entropy codes of a compressed meta-block.
+type brotliMetaBlock struct {
+	literalSplit     *brotliBlockSplit
+	commandSplit     *brotliBlockSplit
+	distanceSplit    *brotliBlockSplit
+	postfixBits      uint
+	directDistances  int
+	contextModes     []int
+	literalMap       []int
+	distanceMap      []int
+	literalCodes     []*brotliHuffman
+	commandCodes     []*brotliHuffman
+	distanceCodes    []*brotliHuffman
+	remainingToWrite int
+}
+
+func (d *brotliDecoder) readMetaBlockHeader(length int) (*brotliMetaBlock, error) {
+	br := d.br
+	mb := &brotliMetaBlock{remainingToWrite: length}
+	var err error
+	if mb.literalSplit, err = readBrotliBlockSplit(br); err != nil {
+		return nil, err
+	}
+	if mb.commandSplit, err = readBrotliBlockSplit(br); err != nil {
+		return nil, err
+	}
+	if mb.distanceSplit, err = readBrotliBlockSplit(br); err != nil {
+		return nil, err
+	}
+	postfix, err := br.readBits(2)
+	if err != nil {
+		return nil, err
+	}
+	direct, err := br.readBits(4)
+	if err != nil {
+		return nil, err
+	}
+	mb.postfixBits = uint(postfix)
+	mb.directDistances = direct << postfix
+	mb.contextModes = make([]int, mb.literalSplit.numTypes)
+	for i := range mb.contextModes {
+		if mb.contextModes[i], err = br.readBits(2); err != nil {
+			return nil, err
+		}
+	}
+	literalMap, literalTrees, err := readBrotliContextMap(br, mb.literalSplit.numTypes*64)
+	if err != nil {
+		return nil, err
+	}
+	distanceMap, distanceTrees, err := readBrotliContextMap(br, mb.distanceSplit.numTypes*4)
+	if err != nil {
+		return nil, err
+	}
+	mb.literalMap, mb.distanceMap = literalMap, distanceMap
+	if mb.literalCodes, err = readBrotliPrefixCodes(br, literalTrees, brotliLiteralAlphabetSize); err != nil {
+		return nil, err
+	}
+	if mb.commandCodes, err = readBrotliPrefixCodes(br, mb.commandSplit.numTypes, brotliCommandAlphabetSize); err != nil {
+		return nil, err
+	}
+	distanceAlphabetSize := 16 + mb.directDistances + (48 << mb.postfixBits)
+	if mb.distanceCodes, err = readBrotliPrefixCodes(br, distanceTrees, distanceAlphabetSize); err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+func (d *brotliDecoder) readCompressed(length int) error {
+	mb, err := d.readMetaBlockHeader(length)
+	if err != nil {
+		return err
+	}
+	for mb.remainingToWrite > 0 {
+		if err := d.readCommand(mb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readCommand decodes an insert-and-copy command with its literals and its backward reference.
+func (d *brotliDecoder) readCommand(mb *brotliMetaBlock) error {
+	br := d.br
+	if err := mb.commandSplit.next(br); err != nil {
+		return err
+	}
+	command, err := mb.commandCodes[mb.commandSplit.current].decode(br)
+	if err != nil {
+		return err
+	}
+	cell := brotliCommandCells[command>>6]
+	insertCode := cell.insert + (command>>3)&7
+	copyCode := cell.copy + command&7
+	insertExtra, err := br.readBits(brotliInsertLengthExtra[insertCode])
+	if err != nil {
+		return err
+	}
+	copyExtra, err := br.readBits(brotliCopyLengthExtra[copyCode])
+	if err != nil {
+		return err
+	}
+	insertLength := brotliInsertLengthBase[insertCode] + insertExtra
+	copyLength := brotliCopyLengthBase[copyCode] + copyExtra
+
+	for i := 0; i < insertLength; i++ {
+		if err := d.readLiteral(mb); err != nil {
+			return err
+		}
+		if mb.remainingToWrite == 0 {
+			return nil
+		}
+	}
+
+	distanceCode := 0
+	if !cell.implicitDist {
+		if err := mb.distanceSplit.next(br); err != nil {
+			return err
+		}
+		distanceContext := copyLength - 2
+		if distanceContext > 3 {
+			distanceContext = 3
+		}
+		tree := mb.distanceMap[mb.distanceSplit.current*4+distanceContext]
+		if distanceCode, err = mb.distanceCodes[tree].decode(br); err != nil {
+			return err
+		}
+	}
+	distance, err := d.readDistance(mb, distanceCode)
+	if err != nil {
+		return err
+	}
+	return d.copyMatch(mb, distance, distanceCode, copyLength)
+}
+
+func (d *brotliDecoder) readLiteral(mb *brotliMetaBlock) error {
+	if err := mb.literalSplit.next(d.br); err != nil {
+		return err
+	}
+	var p1, p2 byte
+	if n := len(d.out); n > 1 {
+		p1, p2 = d.out[n-1], d.out[n-2]
+	} else if n == 1 {
+		p1 = d.out[0]
+	}
+	blockType := mb.literalSplit.current
+	tree := mb.literalMap[blockType*64+brotliLiteralContext(mb.contextModes[blockType], p1, p2)]
+	literal, err := mb.literalCodes[tree].decode(d.br)
+	if err != nil {
+		return err
+	}
+	mb.remainingToWrite--
+	return d.write(byte(literal))
+}
+
+func (d *brotliDecoder) readDistance(mb *brotliMetaBlock, code int) (int, error) {
+	if code < 16 {
+		short := brotliDistanceShortCodes[code]
+		distance := d.distances[short.index] + short.delta
+		if distance <= 0 {
+			return 0, errBrotliCorrupt
+		}
+		return distance, nil
+	}
+	if code < 16+mb.directDistances {
+		return code - 15, nil
+	}
+	code -= 16 + mb.directDistances
+	postfixMask := (1 << mb.postfixBits) - 1
+	high := code >> mb.postfixBits
+	low := code & postfixMask
+	extraBits := uint(1 + (high >> 1))
+	offset := ((2 + (high & 1)) << extraBits) - 4
+	extra, err := d.br.readBits(extraBits)
+	if err != nil {
+		return 0, err
+	}
+	return ((offset + extra) << mb.postfixBits) + low + mb.directDistances + 1, nil
+}
+
+func (d *brotliDecoder) copyMatch(mb *brotliMetaBlock, distance, distanceCode, copyLength int) error {
+	maxDistance := len(d.out)
+	if maxDistance > d.window {
+		maxDistance = d.window
+	}
+	if distance > maxDistance {
+		word, err := brotliDictionaryWord(distance-maxDistance-1, copyLength)
+		if err != nil {
+			return err
+		}
+		if len(word) > mb.remainingToWrite {
+			return errBrotliCorrupt
+		}
+		mb.remainingToWrite -= len(word)
+		return d.write(word...)
+	}
+	if distanceCode != 0 {
+		d.distances[3], d.distances[2], d.distances[1], d.distances[0] = d.distances[2], d.distances[1], d.distances[0], distance
+	}
+	if copyLength > mb.remainingToWrite {
+		return errBrotliCorrupt
+	}
+	mb.remainingToWrite -= copyLength
+	start := len(d.out) - distance
+	for i := 0; i < copyLength; i++ {
+		if err := d.write(d.out[start+i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1037 @@
+package traefiklogger
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"io"
+	"sync"
+)
+
+// brotliDictionaryWordBits is NDBITS of RFC 7932 Appendix A indexed by word length.
+var brotliDictionaryWordBits = [25]uint{0, 0, 0, 0, 10, 10, 11, 11, 10, 10, 10, 10, 10, 9, 9, 8, 7, 7, 8, 7, 7, 6, 6, 5, 5}
+
+// brotliTransforms is the list of word transforms of RFC 7932 Appendix B.
+// Types: 0 identity, 1-9 omit last N, 10 uppercase first, 11 uppercase all, 12-20 omit first N-11.
+var brotliTransforms = [brotliNumTransforms]struct {
+	prefix        string
+	transformType int
+	suffix        string
+}{
+	{"", 0, ""},
+	{"", 0, " "},
+	{" ", 0, " "},
+	{"", 12, ""},
+	{"", 10, " "},
+	{"", 0, " the "},
+	{" ", 0, ""},
+	{"s ", 0, " "},
+	{"", 0, " of "},
+	{"", 10, ""},
+	{"", 0, " and "},
+	{"", 13, ""},
+	{"", 1, ""},
+	{", ", 0, " "},
+	{"", 0, ", "},
+	{" ", 10, " "},
+	{"", 0, " in "},
+	{"", 0, " to "},
+	{"e ", 0, " "},
+	{"", 0, "\""},
+	{"", 0, "."},
+	{"", 0, "\">"},
+	{"", 0, "\n"},
+	{"", 3, ""},
+	{"", 0, "]"},
+	{"", 0, " for "},
+	{"", 14, ""},
+	{"", 2, ""},
+	{"", 0, " a "},
+	{"", 0, " that "},
+	{" ", 10, ""},
+	{"", 0, ". "},
+	{".", 0, ""},
+	{" ", 0, ", "},
+	{"", 15, ""},
+	{"", 0, " with "},
+	{"", 0, "'"},
+	{"", 0, " from "},
+	{"", 0, " by "},
+	{"", 16, ""},
+	{"", 17, ""},
+	{" the ", 0, ""},
+	{"", 4, ""},
+	{"", 0, ". The "},
+	{"", 11, ""},
+	{"", 0, " on "},
+	{"", 0, " as "},
+	{"", 0, " is "},
+	{"", 7, ""},
+	{"", 1, "ing "},
+	{"", 0, "\n\t"},
+	{"", 0, ":"},
+	{" ", 0, ". "},
+	{"", 0, "ed "},
+	{"", 20, ""},
+	{"", 18, ""},
+	{"", 6, ""},
+	{"", 0, "("},
+	{"", 10, ", "},
+	{"", 8, ""},
+	{"", 0, " at "},
+	{"", 0, "ly "},
+	{" the ", 0, " of "},
+	{"", 5, ""},
+	{"", 9, ""},
+	{" ", 10, ", "},
+	{"", 10, "\""},
+	{".", 0, "("},
+	{"", 11, " "},
+	{"", 10, "\">"},
+	{"", 0, "=\""},
+	{" ", 0, "."},
+	{".com/", 0, ""},
+	{" the ", 0, " of the "},
+	{"", 10, "'"},
+	{"", 0, ". This "},
+	{"", 0, ","},
+	{".", 0, " "},
+	{"", 10, "("},
+	{"", 10, "."},
+	{"", 0, " not "},
+	{" ", 0, "=\""},
+	{"", 0, "er "},
+	{" ", 11, " "},
+	{"", 0, "al "},
+	{" ", 11, ""},
+	{"", 0, "='"},
+	{"", 11, "\""},
+	{"", 10, ". "},
+	{" ", 0, "("},
+	{"", 0, "ful "},
+	{" ", 10, ". "},
+	{"", 0, "ive "},
+	{"", 0, "less "},
+	{"", 11, "'"},
+	{"", 0, "est "},
+	{" ", 10, "."},
+	{"", 11, "\">"},
+	{" ", 0, "='"},
+	{"", 10, ","},
+	{"", 0, "ize "},
+	{"", 11, "."},
+	{"\u00a0", 0, ""},
+	{" ", 0, ","},
+	{"", 10, "=\""},
+	{"", 11, "=\""},
+	{"", 0, "ous "},
+	{"", 11, ", "},
+	{"", 10, "='"},
+	{" ", 10, ","},
+	{" ", 11, "=\""},
+	{" ", 11, ", "},
+	{"", 11, ","},
+	{"", 11, "("},
+	{"", 11, ". "},
+	{" ", 11, "."},
+	{"", 11, "='"},
+	{" ", 11, ". "},
+	{" ", 10, "=\""},
+	{" ", 11, "='"},
+	{" ", 10, "='"},
+}
+
+var (
+	brotliDictionaryOnce sync.Once
+	brotliDictionary     []byte
+	brotliDictionaryErr  error
+)
+
+// loadBrotliDictionary inflates the static dictionary of RFC 7932 Appendix A on first use.
+func loadBrotliDictionary() ([]byte, error) {
+	brotliDictionaryOnce.Do(func() {
+		compressed, err := base64.StdEncoding.DecodeString(brotliDictionaryData)
+		if err != nil {
+			brotliDictionaryErr = err
+			return
+		}
+		reader := flate.NewReader(bytes.NewReader(compressed))
+		defer func() { _ = reader.Close() }()
+		brotliDictionary, brotliDictionaryErr = io.ReadAll(reader)
+	})
+	return brotliDictionary, brotliDictionaryErr
+}
+
+// brotliDictionaryWord returns the transformed static dictionary word referenced by wordID.
+func brotliDictionaryWord(wordID, length int) ([]byte, error) {
+	if length < 4 || length > 24 {
+		return nil, errBrotliCorrupt
+	}
+	dictionary, err := loadBrotliDictionary()
+	if err != nil {
+		return nil, err
+	}
+	offset := 0
+	for l := 4; l < length; l++ {
+		offset += l << brotliDictionaryWordBits[l]
+	}
+	bits := brotliDictionaryWordBits[length]
+	index := wordID & ((1 << bits) - 1)
+	transformIndex := wordID >> bits
+	if transformIndex >= brotliNumTransforms {
+		return nil, errBrotliCorrupt
+	}
+	start := offset + index*length
+	word := make([]byte, length)
+	copy(word, dictionary[start:start+length])
+	return transformBrotliWord(word, transformIndex), nil
+}
+
+func transformBrotliWord(word []byte, transformIndex int) []byte {
+	transform := brotliTransforms[transformIndex]
+	switch t := transform.transformType; {
+	case t >= 1 && t <= 9:
+		if t > len(word) {
+			t = len(word)
+		}
+		word = word[:len(word)-t]
+	case t >= 12 && t <= 20:
+		skip := t - 11
+		if skip > len(word) {
+			skip = len(word)
+		}
+		word = word[skip:]
+	case t == 10:
+		if len(word) > 0 {
+			fermentBrotliWord(word, 0)
+		}
+	case t == 11:
+		for i := 0; i < len(word); {
+			i += fermentBrotliWord(word, i)
+		}
+	}
+	result := make([]byte, 0, len(transform.prefix)+len(word)+len(transform.suffix))
+	result = append(result, transform.prefix...)
+	result = append(result, word...)
+	return append(result, transform.suffix...)
+}
+
+// fermentBrotliWord uppercases the UTF-8 character at pos the way RFC 7932 defines it, returns its length.
+func fermentBrotliWord(word []byte, pos int) int {
+	switch {
+	case word[pos] < 192:
+		if word[pos] >= 'a' && word[pos] <= 'z' {
+			word[pos] ^= 32
+		}
+		return 1
+	case word[pos] < 224:
+		if pos+1 < len(word) {
+			word[pos+1] ^= 32
+		}
+		return 2
+	default:
+		if pos+2 < len(word) {
+			word[pos+2] ^= 5
+		}
+		return 3
+	}
+}
+
+// brotliDictionaryData is the deflate compressed and base64 encoded static dictionary.
+const brotliDictionaryData = "" +
+	"XP35d1RXti6IkueeqjrWK1fftzsj703DOah1CxI6A2M77by202XIk3VPVg6PFXvPiFhox1rba60tKcj0GKIRiB7SYIwBY0yPQYDp" +
+	"hEQzxuPe38VPVxrvF496igjpjXp/Q77xzbV2kPXuPYlBitjNauaa85vf/KaTdUr0mEplhVKquLKIR2KdUCKcsDU9plXasNJRLF1D" +
+	"Z6S25talcoQqhmhMmxFH465BwuhRMmWdNFI9ShVt6mWtR7JUNFI5SqlUVKM0q+k6WZlQXRt8N0m1qroa1UcljVWkSjJRpUQ0bCVP" +
+	"0xqJxJGpk4hrwpCoGF13Jqe6MCOinFKeaVWT1VoiHKVCJYrGLI2SUjTuYmGprF0t09bllpK6SKgmVFIjQ2M14ZSo04dSjZRTXbVy" +
+	"G5WFxfMldTFCdSFVbsm8sib6h5pOE1KJHZOu9jGNWUMiGSNDVlaVEyNUE6NUFXWyRCoWaZoJVxujNM3S3NZJ5RWZ1jNh3FYtlatJ" +
+	"m0rrqloniigZEw07RtZt1WVblyoRqdWprmoj41puyabCOkeiLky9UdE6GZGqOibTlIR1Y8IkZbKuIg19Iqo0ovSYGBONnkxV63qU" +
+	"XE2oVIukKkfJUlpR2lE9j2sVwliohtHxiIy10iqmVOuRmkwokZS8r+tk8pRq2jqxVYxLVdFxmpdTMWZTsrYm0orFHOZxbZtW1N/X" +
+	"96+0IhsLQ1tknYyIqZzmVNG5GSMaqYiYajqjqhilmsB8WzdWI5UJMzJCmcuEtbYmM6N1/f0tH32YpUJtaWSU4KJilEaIskoqqqlU" +
+	"I1anSUWOktN6xAhHTo+prXk9c7XcJsKMxMIkFZlShYSxTjRGZJq6mnAVkaYid5pGyfTEuu5EOmJrOhvVjhKirK4TzKhzuVFlbVRZ" +
+	"qKRCaWq0pdykq+2IVEanFGssRWdFlWydyFV1mvRszarSUX1UmEaFUudqpCypJDE6+ydJY7HOGv09faWhXjFsnc4otZRKsk7nJhPx" +
+	"SE9VVjJhXWztP1aNaNRJqF9W3aCRCdmadqlwZIVMjBbJqDBRhSjdqmvKyHgk08ZVhHWv/HZjd0IiGeotD2damzLeu5HRb3s294xp" +
+	"ndRz6way8cEPVEUboUbGZEJjQrkxkWIxJb/v+8NgJvJ0TIySzQ39y9Wv/GJMSFcX1gpTt1VNtiqkSoWqZkImP+/ujlIdj+RKOqM1" +
+	"rjNSkaY+Jis0Xk9LFnuarBvoy8ZHpEqMHrNO67SilasLmVpRIeuEqYvMxtqQEVJVUj1WFuWGzYSyomFfy8YH38jGB4VxtqK1MyTS" +
+	"MTkiayScdZQ5IzNtqr2pGKExEiNOp8l72tRjYV1FKFsWamSUTMPkym7N04YTdqQ/Gx+sapFWDY3ZVI9RUiWZbChZcvb1bHywZ6v9" +
+	"x9f6snFZiVZbrZUl4ZRW5PIybSOsBuUMUVIRsZPK6aqsuJow9f63svFY1Kkm07Ss02Sb1vVRLRMStmGkqlZkmmYkRqSSLtbWvZqN" +
+	"D24V8YgTVVuWzhqdppRIN6JoTJEwQz/v7q4aPfbrzb/5OMld42PYF5FSQ+dRqp3NhFRbxbZtMWxTg2xF2trY2FiPkXbEibLNDI32" +
+	"92XjRloaeD0bfzvNKZGq+mpf39qySNOKNgkJo8ZkmpT1eE9FSJOKGOOFa5utuSJHcU1WVv88k/EIjcr0X64u/WJMmHqqTZJoslme" +
+	"pmv7+vrwlokRY7W8SjbTrpKrpJwbVTNUiSlNR6hhnYxHajo3qba2klPaP5CN21y6hET66ebNJZj8qqHGr97dUiJhSci6reKRZGLf" +
+	"ysYHlRhtVI1MnMzsL9atWzcmjE1F0oiFsWsGoy+yWvaPNUpTJ9J0rKbr22rrm3/f+3JX1N/XVxNp2tPVtfHNbHwwy20trgnXl40P" +
+	"xobG/r53qLcmbO3N17PxSiqcEYaiX/4ycpSmsahnWjmNh6hLa+2IzBwpV5GK6iKlKjmbpdq91te39uWul7tirG8i15PVsqGyGSYj" +
+	"47q2rprLpExpmpCNa0KauoBF0vVeWa/+8hdvDaR5PBKTcn19fYNOqkZVK6q5OqxEmpi8+t6n776rdEJKxiP/KJMNKVZhnqajOAOk" +
+	"Sj7dvDkaI2EMpY0ykbKiTkk+QkpYEYuMxqStVfO0smXg1fU16axNtasKRyMyHimnuXE1avS/no2/8sqawTWDpeG6lTQmlS1Lk1ht" +
+	"XJmcsEQjW/rfWq9NYrEB6iJN3+jLxivC1P/PiZO2rBv2931/6HllzWDpk99s3lImYUZkYtcMfvFFXZiGI5Ws/u2/XvN5LpJttfWt" +
+	"biu3dXd3d2dGZ6+sGXw5lRW3pX/d+lEZk1BJI6GyG/508+ZM61QRnlOPbel/Y32itaFRkW7pf3N9Ss5WhEy1EWmm01TpURHr1FZJ" +
+	"UfR/ThyzuuKMrpOTbMftUO3V4UznpiISyqQaGXJmuC6VXPOnn6+uS0XbauuXy8LYGgnT17dmsC7Tkai7e1garSqGkkTakTFSzmqZ" +
+	"ZrmzvVttb02njS0DA+s/2Pz2x1sG+taLRNQtkR2qDQxvtVq9sjZ6JdbKbRnoXx99unlzqnUmrBR1rdVQbzZsdZ5++MHH71a0cbEw" +
+	"bkv/a+uHav3Db/Vl4z/v7h5al40Pbul7bX1djtD61974ZyVjkiqu/RttRoyM8cxLr6xZM5jlhuqiSpkwwmlFZa2S9a+++c+f6cpn" +
+	"r/xhzWBfX9/abbX1bSfUSAN+gx5Ly7mtrX/9jX/+tRgVr/Zl411/+qLrX2169V+tf/W1f95Klcq7//snH8TC1kalFVWdVqzSY9tq" +
+	"61c+z3GGWmtlPFIn4epS9ZSlShJK05o0lMnYGlJu/atv/PP7W7Z80j3Q11/RTo/ptPLux+9E42U9vv711/757d+8828SGY8Mdn3R" +
+	"RePSrX/19X8eFcaWSbhXvlgzmEhy69atGxRK0RdfDPX+Xv6h50OhqiP1/+ePY9KQ0w0rksRaEqlIaXyw66UvKK5pJRX1aFPt63t9" +
+	"jdOqsZXGrBUqSalqjdaVvr6+NdFAX9+YVFQlYRJdtWWNPWEace6sa6TkqJ45qVXPeD2NdTxSFar6L1e/0vN6Xzb+Sa3nnbq0sUjZ" +
+	"v1IJ2ZG6TMk0hMqVHE+kjdcMftGV5NbFqczW9HR1vdmXjXcP9PW980/v2Df/MDzkREbwgOU//MOaMVElyo3OajLVmbM1ndJ7G/83" +
+	"K6xU3QNvbElhz8nZ3376YVTO05FY65HBL17uev/dje/8vu8Pa0S5bLbmQq3uX/dWSrYGozDUK4etVo1qDj86HslkRn/q7vp5X9/A" +
+	"GpXosd/3/2Hw938Y7PpQVyMrUvdy10svlYWqOiPrZeFqa/74clcf1sQXawZH9Pr/q0JkRTL8sl3f2xv9/g+DTrN/W1295o9df3y5" +
+	"K+rZal8Z6OvLklykZS1cz68/+dWawa4vPs+1WzPY1fXKmsGul7u+eHmgr/+1gb7+1wf6+t8Y6Ot/c6Cv/62Bvv51A338//sH+gYG" +
+	"BvoGXh3oG3htoG/g9YG+gTcG+gbeHOgbeGugb2DdQN+rfQN9r/YP9L06MND36qsDfa++NtD36usDfa++MdD3Kq736kBf/8BAX3//" +
+	"QF9/30Bf37qBvr63Bvr63hzo63tjoK/v9YG+vtcG+vpeHejrGxjo6+sf6Ovr61+3bl3/unVv9a9b92b/unVv9K9b93r/unWv9a9b" +
+	"92r/unUD/evW9fevW9fXv+6tdf3r3nqrf91bb/ave+uN/nVvvd6/7q3X+te99Wr/urcG+te91d+/7q2+/nVvrutf9+Zb/evefLN/" +
+	"3Ztv9K978/X+dW++1r/uzVf717050L/uzf7+dW/29a97Y13/ujfe6l/3xpv96954o3/dG6/3r3vjtf51b7zav+6Ngf51b/T3r3uj" +
+	"r3/d6+v6173+Vv+619/sX/f6G/3rXn+9f93rr/Wve/3V/nWvD/Sve72/f93rff14l76B1/pfXfdaX19f37p169bFuq7rz85Zso7I" +
+	"YuUZ7XSiayKmWCRCPLuty5JU8uyGEPbZjVGZiFhYrZ3RFW201Sn+LuJcpIncqq1MdNUI5WSGiEIkVCaRVvXn+bOrZJ1WIhHOwBWI" +
+	"dSysKIutuB+WsxbVXGQ52VxpK5SjRMaU5hJHnaiLht6mlRB1bTJptS4bEaf4QKoTqW1NGzyXnH8wf2v+8fyt+Sfzj55PzN96PvF8" +
+	"5/Md/LN780/nn8w/eb5jfmb+wfzj+Sfzd/lfT+bvz9+bfzL/eH7m+eT8mflb8/fm783ffr5//tHz/fN/fr7/+fb5J/M/Pt8//+P8" +
+	"k/kzuMb87fmz80/m5+Zn8K357+YvzJ+Zvzd/+vn+5zue75//ln/6aP7u/K35E/O35o/N35o/83zn/Lfzt5/vmL83//X8g/l78w/n" +
+	"Hz/fOf9o/tH898/3P985f39x++K+hfOLuxcnFy4vTi48XNy5uGvh/OLUwu2Fm4v7Fi4vbl/cu7hncWpx1+Lk4q7FnQuX+e97Fq4s" +
+	"3Fm4vLhr4eLi7sU9fI19izsWbi7uWdy18MPi5MIV/I0/u2/hyuKuhcv8350Ld/mqkwvnFycXdy9cW7iyuG9x1+LuhRv8jcnFyYW7" +
+	"i1MLdyvSWDcqE9KprNbcmDZpUqdEirEaov5UWyrDPzT4rcXpj8jeZqmIqZ5bGVckpYk2CZkMYe6oSHNKaZRShzC9rIVJajq3VDU6" +
+	"z4AYWMAF1joO4hLRGBOOjHXCeAOckEDkPkYmq2lFCvclY7SRKsudKOvcARewTrqUEFlw2O9SHYsUYIZNhakyvGA5NsfqgdscU0XH" +
+	"uY1TEgZBX1pGGAMXjYzgVSaAStRJCcQ7da2oIXGyAi2wDZ0rxKG4vCMT61SbqiFSFaOV+6WoZ4NjwsW1ijYxZTigEUjbMlWlEhVH" +
+	"ZlRa6aS1OQlDwpYp1XDhaNxpJ1J4yDYVZUozI5XLDFlbzmXqOPS1GVFiXZ40nBEJwmuVWFKWcpWQAUqD4K5ujVBVEkmCD8s0BRSQ" +
+	"AKdQogxUJhW2VpHjlOiKI6VdDU9FYzauUTySUlWkRo6SQURrP89lPGJrIqNaXheKxhm6kIwvSHI1aZKysDLOSMQwLVUak4mrpboq" +
+	"FQc/Y0Y7AqBjc0vGJri0ddpQ2ZAYsTp3tVEtY7LSka1r5WpjNTKEl07GajKGL+YwnHnd1QzBqBgHMKWxKZXxCCI4A2zJxqmwNhUN" +
+	"gudhGrhHI+cwHcMU69y4hs5NVJYG6yrLM4THVmRZ2vgA81smqap5lpFR2hFWk2lgTC3CcUvjzog6phaGcARQiyJh4HBXhbJ5RiYT" +
+	"/F3jalhbCqiLwqIBdkTJFixWPLj9FXZA2QiV5BZojq4LVRGpJUMiaYg8kRqzZcdqMiVgFb14wSQWlmwiZNqIazJFsCTc1jypkqtp" +
+	"LAHprMJDl40WSayFdTHAOLwgARGxcSPGn6QIAIuFZR0ZwzR/nhOpTFIMt16mFSPqhLASO8/pVNaliwVWhxyVKdweIuUIYUOdsHrI" +
+	"6TyulTHORjdEKuwIJWPwYqxUWBY6HokwEhUhXY1nFN5VQ1cqZGysM8JgJnVscZGWMdMeldOJMMBDxFZtnIE3JZSGi6VVrHM2MjLB" +
+	"gUObYTI+xKqriSxr6DjODWDM9RVDtvZ5Lh3GoG6r2DgK2yg3ZaEquCUWsK3xWOVODxqdO+pB/FXHJqlIJdJ/g5VjU5mQ05mMy0aP" +
+	"KZFqRQh7lc1S6QwQyk9xvURgKQsT1+D8EJA+C5jRJjovO2EbKna1vI4oXI/FNUmVBraB0qOUImQftGRGKVdOpkAr7Sbsy82wXHC8" +
+	"G1sZafw8F6kbkzH1IQLfDLOZCUUpcBdrMBXElromKzDmHgS1KYnEApKzAuCEq5EleK6w44of2ok0qwn80wFitQYvAwAXG0zWrUjJ" +
+	"AuuF4TbY3ao61Ft7dRhTVoXT3VvPUweIxiSfYHNaJ1Ti9Agpq1OZrAYAWgYiAsQPV6lUnJFY3mnKiG+jEvA15bbUpI16e7u7h0WC" +
+	"AK3ayNy7sPKIVgffxWIFFlyKjbawjsoxlFvW46VhbOIkJTEKEEMJ4Lu2mpN1Q72114aNBjBMYrSBC6zlswMuTRIbnB2yqqwYE4YS" +
+	"oWLKasLSMBAZUp/9dvMvf/HqukH4uuOfYVGnwklFaqtuACvtEYgabF26GqC3CNgxnHcyUiVSKDFaGgYIoBj1AppoE9jTzEhtNuPs" +
+	"gZdpDYwCcGK7VWRsHlLrjBQpdooROHKGemsDw6KcWxIpGcCKRpS6e3t/FwuTWOBQFsCx/QSb2Jnc1XDmKYAT/2iFVK5OAEt0LtnC" +
+	"uQyhUdlIqhg9VhqukjLkTA4bq0fsP+E8fw+WoUeRw5R0DTnTiP7YBUQSiLmNscKyVEglkjx1n2OwnWHMsKwNgH8bi9wiTAUu4rAq" +
+	"SZoBhFSMZwPTs5uwsWOdp0kVFt3KhCwAJQChlGJSkjq2C0412FNpGd5OcphmGhe2YnLpAI+s/dOfOFDJSsNdmDzAEiUdk1BDZdM7" +
+	"XEm1NhaYXEKZq0U+A6BGbAwrj2wDg5eDIpVVlZBI7RieCk87mJt0Qwnwta3Dp/lIWxf19PQM9Yq6VtUyXhpJkEhpRYNIKySxMKaR" +
+	"GFFxhio4K6r0GfIgPXWsjYRS0UgMiXqGDAonCoZ6nRkG/GPx8JHIjIQBQqibkqJxETuABrVYJ2Rx4MbAmiNLVLflVCiGhm20eqCv" +
+	"DyB68hl8CECfln13LKERPIHlzYh9ngAEGBzDcZIJY2n1msFS9Dbco1SqfHyrVmQzOU4pQJ7hNYOysrobRjYRozKpaWPpPbhWRkjk" +
+	"XcbJbsFRSXXCy1B9uCxMabjHmniDg2UQqdtQiuEd1kiZxsBrbPpcnkkn0gb8sKwucZ4K6xirtrAdPYYsIX1AKfBB2xtb24sMxCBS" +
+	"FNa6vFIpy3JKQPYt4L++EW1IfLFm8OUuoPr285xy2hD98YtBgCyDMVIqf0T8K5BsivG+0khbQ3orMtjPcFItkOVSQ9S0XgOwfCM8" +
+	"UGSr7FBvrX84oXJeBcDMUfoGxCz2izWr1wwC6BzMYFUAKVrkF2zf+Bt9fcgalBjHLePocGKcbF3GRsN7S7u7h4d6AS5b62iUujF5" +
+	"WFI9X6wZ7Hqpjk0Sre5ft+69jf/bUK/RVTIVwPqbsGcGAJgiyWOBJA3ZWDvnyDo7gGdJjFQja6I//SlKgQwim5P+ou/VdYNRRZsI" +
+	"ebtkDCMOAze4Vaz/f++wsq7VEJB7Q1naQNrD5vAA4J1lDufM27Cda6Kfb4gSQxa+RWaN1nWr1Qg16rosU2TUegBjRxl850quVMMZ" +
+	"gt2o95b6MRxjcMgzYUR985aNn27B2opkkpBaGw309QNO6IKD2zMqTe6hVGeEsmPaWIcEGFxrrTLsX+yZLt0dixhZCU61WJyUkbBS" +
+	"KKAufxSJLtMrmEyZbOjvw4EwiHRd1DPQU5dIopUAe6tYi7i2CT5X2eQxDeBJf/vph2v+AX/5E0BssohSa9ji/QN9pQhHrHNm+OUu" +
+	"HBAbEklVjR0fWQw4bGfXLyqVymBp+ENdhYdXcoaEszUitwbocz/vhZQoUx5wTinBVOxEqiLGIJbw0pwdst1lPd6dINDq+mXqBoG8" +
+	"2vWvvfXPQ4lwotvkRqTIPEU4IJOyGOFQIbMbolJpMKtlpeEYo9aPjETZSKEAo2tYww16w78aeC+CNaqLRpmGZL0ayXq1NLw2qmy1" +
+	"+FtUwvh9tEVnbyNjV1I0ljbeEcqOxNuIfWWZwlfGJnl9uCI+Lw1vq3XHqr9vzWBXd39pzSC88A3IFlpn8rQBW2IBJg0Ov9w19HPr" +
+	"iNIISY/awPDLPPvRVsQS2PERZqvn5a6XXu4aEykMmR2xOcxNglRHuTuVIw57qyorpQg5Wpvg0Yisk7b2ucxsruvS6rKhhGxCnNtx" +
+	"OtE2yykh4CKWrHt2zklSVBPWCe2MZjeeEq0SUjmNMo5i8GiiLm1d12mrNvVcJVp8nj+7AVTF2md3Ui0aeSIqhPSDTnAx5RBnapsI" +
+	"p612RlhewcjRalHTRqR5VRjAIoas8wCIdaQIBpwsMFU8n7CZeHbD4lmEFWmeMGiD6F1/nktS8BdtphNsWDh7ZJ6dG6UYbxxLs1Vb" +
+	"hm2EgWclqibPdA0wKi8DR6qqRV1WdaytsAppfLhmhFcVQhpCak0yDmRrIpaiIkY10kdSp7JsKMuV0+WclIZ3bUTZyBT/FPAidV2Y" +
+	"bdqKMoI46+BjVHX87E5d4yX1VvwzI/NstoaPYAwaKlexqOdbyYyKVJtKjoNElo2u5tYJWc1FOopxiYXVtpo/uwG4KdFW17XF2tK5" +
+	"dZQAslJKxzUq5zYWFZE6QbnR1hKcURnXdJwbq+MUDqSwwqb07I7KUrFNA1hgbMqO4plFpht6K97SGeFwC6djQ8Jw+qhGdW1jGJEY" +
+	"XwOsZbVJSGHRqGfnDAnAxTqjxOiYTMyPKxBLplgb5tmsk2mcCqO3alMluMmUaUXGCVxGJJIQawgrqzlRCvsvq6mO8W5wsmxdJIYQ" +
+	"HfsBVPLZbf25v4cVCIJiXQOoNyrFVspEOdXPruLMwZI3JJVOaKswFa0SHQslUpzIlBLCYJFbwXNYF0rbNFdkMcl2VKYYN5VoRNgG" +
+	"OCEvJIEn1WlKoyLDU+VKJnpU1LUF7GdFvayRtE3wOSng2/JeEDYvS2Ok3ipG5ag0COJ0XJOxEGn67MZWjVvJuCaI9xRMgRWpNDan" +
+	"FE+gbYURGyCMmG5+QYxjVSiqGo1ZFbUcqKOoCqxdh7MhJWlTYcs6BYT57LauiXIqUjzQs+08bzF4MFVhlHbCjuKKeKBzmGmR6BSr" +
+	"XWzVsCBXsTqx2bFPjNQ4lAQcZl3Bg+NEFDVdFQaWxabI6IrPc0rruSUNB5LBVIQVIoklpfz6ui4p0VWhhLGwJeQwrFT2AZtgo2Yl" +
+	"OYoxZbHGM+eJsAlZAnyzVeNvApCs/SVi1cFE45SOdb2uFZye3INqtg6zY2zD4vH5uCgLpcgYYjc8NjpN8wyBbzXVZZHC9uT1ikwd" +
+	"GZXXy7A8cGsM2Tx1WV5OZWxjQ6TimtYW67wuUmcESELW5mStzk1MThhOshpGnJI8JXYlCFScuMbwhC0z6GioKjUwEEorVsdSpHjO" +
+	"UYp1mteVwdsnFY2ImwHDYZJALlIC86gi6jJtVAwg8FQgGBe5q2mDXezIEKNjeb1OhiNzg9GlhP80NJ4JlWQ6lXGDjwCHKB9bVCrn" +
+	"rYnNyFitUjkqVTXh4JKBLssgoc2V/DynMQ7XM9JZSth41YYSLjeYHxPXKrKaG/CMpKrGvJJ0pWIJyKcjAwBRj9m8XJfOkErIMMhq" +
+	"8yzVIqmRSF0NSI1OGOC1FoOeVnK+fk0keiyhsnDEmK39TXkrxY5hQevR3pRENae4Zpi3Vc9SUtrJmBgqBbqFAIKE1coQgg68qyL7" +
+	"eS4A5DnYHABsFgAkZhBvwZwWJKocmfcMR9lkpE6sA6RgKCPhPoTNUQk5gFLa1GG26/DgKc4NZcJaSpyuVlNiGNomhOwx1q2MY+kk" +
+	"WesQUzVwko0J50Q8gp+QqzDIXWPfEuym0rDOSFGSW6rkKe/kBseqePcE8x4bcpZirRJYp6rHIS2Nx5TB7GMEZFVRwjiIpUqFYseQ" +
+	"uEe4ra5UZEyj0uaCeR7ajGJl0qc8YtjmeR0jwzEtzuE4Jmvr2rq0UefJKEVw/gDKkKsywoM9hQVkRqlRpoo2ZBv1sk7rbGhsRhTX" +
+	"6hq7VSrm2wmslk0M3mmeZcZzbV0mSUrvIgMJczgGALMq4kad9zgpnVdrsTBE7GyT0UZWpcJzizROJe5FKcXwxRNd51xBwjgZXHtw" +
+	"XwSeX3M+lddPg0PEhGwsMngaFnYgN3EtIVhqw2sp1kYRwLgsd3Wqa9OQjEzyXiOG7u3HbFuSHPbBo4mcO7BgfVECl8IDS5RYmY6S" +
+	"qQtTBdqekqMy7x1AeZYY47S/YtsFTBaREZDVmMeqzCir0mNGZLHB7HksjFQV61xUyDXiGqPYmTTSdfuHyGBv66D8VBVRQonJrZUi" +
+	"S0lYonE49ptjIzNXNoDHGA5EVGOqlEjsVABhWPZ4x25GEVyNtGnEGsCl4DWATAolAFco2cRjKOuZiJFEztNEpOAXprqqS1FZO6fr" +
+	"GJPS8Jo/ArXJDFXkuOZ0wfu8zntAj1kd6zzDCoB/UTYYiFTkKq59ypbQ8Rshj4F97lxKCa//t3mnl0nkrsHQMOxbVbvNbMEELL0R" +
+	"Ka9oLRI8rT8ROCJ2mEFD+M1HbOH5FCKbA57fzGcBm3mLJ6Dkl6pss0GA+pY4EI9wVKtkCLBthPy45HzNhpKoi21aYbUYJZJRZk8g" +
+	"GlofbalRlEiR6irnpezb7/7qg4+jj2hc4lwWxtmY44EaW2bBIcUHfBdhLTn7bj2ThjazLaUKoJhEGoqdTwvUhcpFupn3RU9X128U" +
+	"gzqUIGQpDX9Sk6nMGMyyNWYmyDr202/YShgee17EVjGeuBm/tAlVDRHg27QRraaeak+ZauBdaKyTVFerlCAPQMwCHOrlrBNDu84K" +
+	"ayW/lbQ+/ZS8igD/T3DpEgG0NW4gA1Qnw9zXt43YJlMrYO15/QyXqaFV0s34P6xT5vg0TODPKHpPa0dweckIBkS6PoPJLjECy3bY" +
+	"2lLUO/xyVw9HY5qZqfgfmd+MV7RJ8HxkYM3kKO9cVWGW1TsejkU8WhJZhtOMxl1pmJOLFhzXEQsGMIdgMSUCp00qVEMr2lgx8BIx" +
+	"bHCCSblP+IQdKpuod3gMh4vhLB3jMpT86U+AhfD+pWHpT7FcITdpRFYaBteIkpiUzcHVzWFDRKwVw/y2H8jgn/j4wRhq57MpJSA3" +
+	"lPBJOog3EXyWibSfcwz4SZ7BwYmpkmrh1jPwEHHGq2cjnwKcWLLWp/tgN5L3PbgPPND8dst73W+VOPUYvc2nQE/0O+lqSBRKzXix" +
+	"rQsFbpDRFem2/m85M1WwPhlrsWUYeFcRwDKrWlcx49iaQEmiP0prBKVWNNh7iWVCQA1LwzW2GBgMJPOwvzmFZDmfOsjDkDD4OmRE" +
+	"zLsbc9jdDWZrJsAjtzQOtkhuSOSMhkX9nM3QZQd8Gn6aFQx1rY0+UDGAEkoavIOitGFkbDlx3AOLTglnhBqfYbH2vMceHYBrSj7i" +
+	"EzO1FMnKJ+yzudyMUGPNIHNpObSusm/G6WX7DvvAX1Bq6Y9SWTLu7VRXh3orvMI5s9lTYX+YcxR2iJFeJG6ivsyIal1UjExEg0Ng" +
+	"k+g0FYb9k4TzcRasGaleZzAKoFBpuKwRl/XAIVotRoXjGMBR8hm/Eq8Ey3tTcKZ4bYVPN/a+mItOhjMAq3l3mM3sB2Lt4spJozTc" +
+	"9fcwdltqmGZLSHNuJWOpAS77UC9SvZUGjWdknFRbc9PgFO6GTWxFGYiLBGdYPmM3DmlYkcAujVKm4xFynG/p2szRQfQOwOAYjonh" +
+	"zL9lRDvZCPg6MgiQGkknv1warmvDzwmDx9l0i7A5t8xHAEj5+z8MMg+hp5pLJ0xFKjBd8YTrkZ2l5DdwLiKkNUqRsMB/eJLtmEwt" +
+	"ohjNo5RKqtgxeKubfCQmrEwbEaeEN/Pp09X1u5pMnWik2jA7YL0hq42rGHgnjkevtCb6h6jEMz5czrEjOI9rP+IzGicOPMmMXUDY" +
+	"ZKSpSsOjTDvgGNhwLjOK4awgEnSA1hz79llGaqhXidHhEaGssLAbpWFOTdsNnB/mhHBU46v1+Q3f1fWBiiKmSXyiUzsiu3kQt2qT" +
+	"CPU2n/icG4y6eS0NsM1BjURpuI8h19+wletm81THujKWsG7BZ4z6eDtGTI6wHB2sIY7+4H1LA25cKYr4u5av3M2b31D13fFsPadU" +
+	"UTMhFYJ2qZjIYTmv8TKnvqOul7AHh0EOe2mo1yXDXfzbrrKoCSsAsci0KlKq6rqoNoTJ+B2tyeyIZMbM1NLMjdZXe9rTE0uPv2w/" +
+	"3L7yw7Glp+da228uzdxonnrcmjq6NHd9aWZiaeZac/J68/BMe/pc+8ju5enZ5plTzcM3l+Yutk4fbO471zpxf+XE3aWZa0uzs0uz" +
+	"l5tfbl/ec6354PbSo+1LM9+0zl5sn97ffHBx6fHp5e3H2nfmWj+ea53e2358tP3Dydbeidbpvcs3dy1Pz+K+T3fikX74rn3sSmvq" +
+	"wcqlr1bO3cMXJyZb+yZap2+unJhe+f7r9qmZ5uSdpZn9y48ft46cbt/9funx0/axK8uPby7N3W+evtKefbo0M7fy/dfL93a1vjq5" +
+	"fHn3yvdHm6e+bV442Lpzpbn7AO4+d7p17P7yicPN3ZPN6YetQ1eWDx5pzuxonp5t3Z9qbb/Zvjq7cuJu8/DR5szOpbmJpYdTzYuP" +
+	"m4f3t4+dbd2da5560t67B7+9fbx5aUfr29Otvftbp2dbX91aOTHXOj3R+upW66uHzUeHmwdOLM1ebx0+svTkFB579lDr1N3mhW+W" +
+	"n55cmrnWvjPXnjvbPntxZfuXrZmZ1tTh5sOnzaP7mpP3l+a+ah4+unzuSnN6b3PySvs6T8ejb5pHv15+emr53IH2jofNPXPtvVOt" +
+	"Mzvbx+41bxxZmvmqffzA8vST5elzzcmDy3cfto6fXN7xY3Pfd83Ji3jsfVeW5r5amrvePL67+eX25sFvmtPftw/dap6+sjSzr3Xv" +
+	"QfPSjqXHx5sPfmzPHW4fP7l8ZWL55qX23O72hSfNA7Ptk3PNx8dbp6+3fzi5cnpi+dL2pbkHre8etY7dbB3Y3jz1eOXk5MqXT1qH" +
+	"LjZPPW5O32/OzS5PP2md3t88vH/l68nlm3OtO8ebT/YvPT7YfjzdnjvcundoZWJva//V5acnW989aD7+srn3YHNqd/v2XOvQn5fP" +
+	"HWie+m5pZnZp9kLr6y9bpyeaRw6tnL2z9PDI0szB5Z2PVya+WX66Z/ncgaWZb9qXj7b2TTQP31ze+Xh5+knzwp7mkamlx6ebFy43" +
+	"p++3LzxZevRN+7tj7av3l2a+XJr5pjl5ZWXnlZVzD9unppefnlzZc2D56detr282H000L+9v7Zxs7r7XPnZvedfR1ld7sK6+3N7e" +
+	"e6A5M93cd7X11Z7mga+wumaP4Po3LjVvXFp6+F3z9K3mmYnWvcPLl6da+44v39vVfHCxfWX/8qXtrds7WhOHlh5OtU7cb06cbO47" +
+	"u7zz8dLM3PKl7e1T0829B5fv7Vqevtk8e6g5s6P11cPWneOY08e3W/tPLW//unn+VuvrQ0tzc5id7Rebsw9ax2+1Dk63nxzCbr39" +
+	"ePnxpaW5/e25g0uPd7e+vokRu7e9fezs0uzl1t6JpbmLeJebZ1pfP2lfmMVCmj3VPHh86fHBpdnZ5tGvm1O7W98ewfOfetI6Mdma" +
+	"mWk+uNicfNC8cal97Gz7zlxz6kT78dHm4f3Nma+aN440b+5uf7d95fujWLeHLjen7uO7B2abZ2dbp683J680Tz1uPz66NLNvZc9B" +
+	"vOP160uzB5pfnW5d/3756anm5MXmYQwydsHsqeWJncs3jzcPH8VSPPtwefrG0txFLMhjT5uzp1tTR5ZmZtuHbja/37ly6WRr5mbz" +
+	"yAEM45XbSw+PNA8fXZnYvjQz0Zz+BnN3+Cgm/cvtre/2NPfs5tsdWr58vrn7TvM0tiTbtP1Lcxebh/ct3z2/NDfXmvpqeWKyOf19" +
+	"a+rB0tz55XNX2pdvNqd2Ny/eWZqdbR2/hTc9NtE8Nt3cM9s+dKt9aa49+7Q5e6x97Epz6sTyuStLMwebRw60H321/ORoe+7s8vS5" +
+	"1tmLyze/xyq9tL11em9z8g6Mw7e7mk+mmhe+af35RuvYk/bcrvbcntaxp+0bX7VP3W0duri883Fz36nWmYut2SOtkztWvvoSxnPq" +
+	"VnvnjZUTV5qTd1aOTS/NfNk8MLty5tvmzMzy/tvLN2+0Tz5pzl5qzhxonT6F9XDxTuvmseUnO9t4ht3Ll3dhZLATp7HgT1xp7Tq8" +
+	"fHl7+8cnzcdXWycutk7DOq388DWszZWJ1lcPV8583zw7u7LnaPPCrvaR3a0zO1dOHmk+vt3eu2fly/vL53innN7bnNq9NPtDe+9V" +
+	"bI25w8tHLrYezDX3fYcnnL6//PRk+/LB5Ztn2A4/aJ25iE237xxOk6P7lqdvNR8fx+kzt3/57pWVPYfbxx5gKT6+3fzyYPPRcRwB" +
+	"+y42j+5b2XkFc/H0h5WJs80/X1m++T2m/tY3rTt4kfaxO8s3sUpb351rzh5bvnmxeWh38/CPzcPXWl/fXH56rHnq2+W7N5Ye3moe" +
+	"Odi+fIuNye7W1BFswJt3saeenGrOHmveuMr280ucL1f2N2cPL81MLE9db53e2fzyHK6GSbzfnN659PRMa9+F5YkDSzPHlub2NS9c" +
+	"bV870Tx8vrnvSmtie3vfffy592Fz6ofl6QtLM7PNp5Mr5+Zap+62vrrVvHUYN5062pzAKsVvr/156fGXzQOTrf3XV3acX3o4tTQz" +
+	"AUt46nFz9yTM0ZFLre8eYqcfu7d8eXfzxqWVk983Jx/g3Hy6p31lf/vY7aXZQ1ioew8298zyOB9cfrqndeN88/SV1teHWl+fbR2f" +
+	"XJrd35y8g/P3uz0r338N+7/v3PKTL5uTF5dmJtqn7jbPzrbPTbSOn4Qpm92NLTM3275xtD33Zevb0ziAjuxcmfgGi2r6XHPqz82b" +
+	"D5dmDy3vnW2dvokTefcB7N9jZ9unz7a/2YXv/rC/fWNve/Zy+/Zc89v97bnDK9cONKe/xR6fur/0+HTrzMXlPdda3+1p33jC+31/" +
+	"89DZ5tSp1onvcExM3odLMLEffsXMRHNqT+vAnubBE9gFJ26snNrdPPU9n4m8ue5caZ76rnXjQnvycnvvntaD283Td5rHd2PJPXza" +
+	"fPAtr/OzKz983Z693Do9sfz0aHvuUfPIFDY77OGXy0+O8jmCswbHys1dS3MPli/vbT45gZ1y8BCOs9kbzekDrakjrV1n8avpvcvn" +
+	"J5tPTjSnbq3smMYZMXW/OXtp5fxZeEEnH7d33V9+fB1eyr6LuNr0LXgyXz1snZtaPnegdewpZu3WUZiRL7cvzZxevvi0dfhIc8/s" +
+	"yuSfm7Mn2rvuN48cWpr7unX9SXvnjfaOh7Baew82pw/AwMIz+Wp5+kn78fTK97ubNx/By5r5pnlgbuXk9+3T+/F/c4ebkzMY1VPf" +
+	"NR/eaR6+1Tyyq335YPP7b5v7vmudOdL66iSW5fffLj3c3zxyaHk7TtLW8anWt6exth/eXZ6ebX21Byv21GNs25t723unlmbmlp5O" +
+	"t449bB7esTRzqLX3y+bBW82nPyw9PAIr9/2t5cs74Jzc+BrO2I9P2he/XT70gAfqavvYlfbc5fbcjaXH3y3NXYf9fzjVunKu+ejw" +
+	"8s0b8Dd2Pm5eOLtyanL55KHWlweXHp9eOX+o9d0j7Md7D9qXj2J9Pv5yeceP7bM34LKeObc0d7/141z7qxPLT4/Aw5m9vDTzzdKj" +
+	"b5anp5s3Hy1PXWtOTrWPnYX/eeps8/BNbKvjh1fOHeC1vWvp8Wl4j7d2wmjf2QOPcc+15r4ry3fPLt8939x9emnmm+XppysnppvT" +
+	"37a+egz35vCJ5ekry9Oz2LMz15qHD65cmoKX9WgW5nfi8vKBnc1Td+Eh7z+59Ojoyokfl+b2tU5cxClz+uDy5QkY9sM7mvu+a3+z" +
+	"a/nmGezBHQ+Xnp5Zvrm9eerJ0sNLrdN7MRH39i/fPN6+DM+ttRc+Dw6y6W+Wd5xrX8S50/rqxsrpidbtHTiDnpxo7vu2def40sP9" +
+	"KyfuruzZg/c6cnHl2AS8kZt726emsd2mbrd2TraO7oJ3cXovzt8Le5bPP4ZXc+NS6/Ts0qNvWsdvNW+cWD4/ufR0evnuDRj53adb" +
+	"Z47CazrzPXbEl9vZHn67NItzvHXrbvPhndbhI7yb9jVndizf+n751vetk9MrE9/AqsxMLc3sW3r0HazB1A+tGwdap35s7b/I58gp" +
+	"uM2TB7GDDl/DiXniPg7iO3uWr+xbfvKkOXVraXYWMzj9PdzIie3Nmanm7ePtC7PNpzsRldy927xxpH3/x/YPJ+H5nzuwvOdae9f9" +
+	"9uzl5tQP7emJ5sHjrWMPV85cxXzN4oSFHd77ZfPRxNLc+fZ325tT362cvIDBn5pZ3rejfWp6Zc/B9o6HrevnWsdnmhe+ad46ipN0" +
+	"6s/L93at7Dna2stPNXexeeQQPMDJK3zfe63TE0uP7zRPfdu+cax54So8jadnVr453T50a2nufOv8ROvsxda+4+2LV5q3DrdO3G/9" +
+	"+GdM9I3zzZmZ9rFvlvfcwfMc2Q2f8PFxnJWPJpoXDjan7q/sObr87cHmw5n2pcPw+U9fbz7+HgfZnt3NC/CB4bKe+rY5das1caa1" +
+	"805r4hDOkYOHl6enl6fxavCfLz5emr2MEObA3vbkZSzF85PNh0+XZk5jgT36YWXPnuW7NxC87N3fntvVPPVk5cz3rTNPm4d3LE/s" +
+	"bB172vpxDuHS1182L1yFy7fvWnPfKZjouevNg3vas5dxTM9ewnztvYo9dfxu69725XMHVia+g+XEGjuxcvZq89Tj5bszuM6jCUzl" +
+	"qZmVE6dhjQ/daj6+jZPu0g7Y8yeHmtMPMcK3Drf2cZR05lzr+hOEijsnl6cxNa07x9nJud+8Pbn08FJz9sTy029bhy62f9jfPHhn" +
+	"aeZJa99FhAYPLjcvXlx+egRRzORFBLBnLvqQdml2/9LcfayZY7dx6Bw81Nz3LQb/0lTz0lT79NnWjfNLMwdXjp1sTl7BKxyZah45" +
+	"sDT3oDnxqDn1A1721NH2mfPNyQdLj79EFDZ7rXnq+5WvcZzBou592to/2fz+25XjT3nHzcKLOLAdp/D0/fbc3aWn083Dt1oPnzQf" +
+	"XGoevrO849zS3OP2jWOtc1PN6W9XTk62j91benoOQdmR3e1jZ5tHHzenzy89+m5pbg4x+I3zzUeH23sfLu/9cWlmf+vMxebBwwgV" +
+	"D1+DxXj8ZXvX/ZU9B2HqJy9j+g7sgWF8egTL5sTF5tMTy3fOw2e7P7n8dE/z9Fm4yvdOtm6fbR27iQPo0BW4vj+cxPOfuYHzdO9l" +
+	"RGdT91cmvlt6uB9Oy6ErzYdPOZacbR0+vPz0VvPw180DX2HLz53Fft/Le+Hk1eWbT+BiHYDFht/4dA9uemgOZ9Deg61j9xEdPLzb" +
+	"nL3UOrOj+fRE+8mhpZnHrdMTOL5Pzq2c3N06fQ3vuO87DlIOLt893zp+Er7Q3qnmmRPLsz8sPf5yZc/B5uzu5p7Z5rFpLDzeL4jH" +
+	"T59dvs0/3/lwaeY03N0d0+3p282DHKff3rG8/UDz8FfNJydwGj4+2D41s/T03Mq5e/CKp3av/Pls+/HRlXP32uem4VeferL0ZD8i" +
+	"ozMT7cs3W99uXzl2cvnmzfapaXhB02eW755pnZ6F03L+0MrJq61DHK2fmGzd/HNz+rv23qsrJy/A3bp6fPmHr9pfPWmfmGwe3gfL" +
+	"cHsHDO8PJ3GS7nra/uFi6+ZhNik3mhf8jt7dunkYp8+Fq627F5s3TsCTPHyrvf18e+5ua9+F1unrwE9mrrUmLjenvwYcMTMDz//U" +
+	"DI7pqa/bxy/C9X38ZfPJ/ubMFIKjJ0/hSJ+6sbzjHOLQqafN2/tb3x2GOTr6NUb75qPlPXfb17ZjRW3/kh3R6wynfNk8MtU6dx4r" +
+	"dt+d5r4rzcOXmhe+wv6d3Nl8dBwhAByGr9vXrzdPneLT+dzytWutrw+tfHOkfXl7c/oMhuvyruUDO1v3p5bvPmxO3m4eOdB8+vXy" +
+	"9C2AVHfmmjOXMO9HDjZvnGiffARs5NFE69gTHKM42U/wSYGV1rxweWXPYUSOHMGt7DncnLy9NPsDh6hnmxfvwOGHtwkMCl7K3Jet" +
+	"M2dXJr5Zenpu+f4k7NgkMIrm4y9xGD36un15ojVxubXrbGvq6dLs5ZXt+1pTP2IVHT4PbGf2QvPWbXgdT880t1/kCOJS8/CO1vFb" +
+	"sC3wqQCRNW/fxpLbOdnadba992Freh9W1LlvW0dON2/tb97+dmn2EAK0qQfLd79HrHT+1srZO83DgIOW734Pd+7MN7AbD39c2Xml" +
+	"NXUEJm7qavvUPkTQJ4+2po6s7HzcPDC5fH6SMbT7iATvnYIL9/Wh1v5TbHAOtI7fhU37+mb79KP2mVNLj04uPbrbvLK9dfoUPMmz" +
+	"Z5sHfwB08Ojr1s7vln/4qnl+D4zh5O3lvTcBgk1OAqK5fql5ZGrl5K6lufvtvQ/hvcwcBPyy/3brqz3L93YBefjuIaJLxCBPgBh8" +
+	"/6g5eX3p0YnWraOtE4eBg+37DpNy+AQm+sal5pGbzX1XViZ2wMAeOts6c3HlxDTM4OnrAAafHFo5f7Y993R574/tK/CC2nNTS7OH" +
+	"EHTsvbr05BQwliOn2/uPNPedWtlzeGnmIozerZnlp6ebu0/ilU/vXTl5pP3Dt0uze5s3Hy7fPrd892H7GMfvFx+35840bz5sHtyO" +
+	"wPbpzuWnp9o7Hi7fhJsE0OnR1819T/HJW0db188v3zvcfHh/aeYYwMwdD7G5jhzl0+EWDPWxKwC+vnrcfPw97nJvV+u7R9g1391d" +
+	"+eZw+8wErNOFywADv77ZunkcK+TkLGz1ga+wy6YPYOMc3908e6g9dxbhz55ZHPp7v1yZ+K597HvgG5d2MEJ1BdHf0zPt4yebp75r" +
+	"3/0O9uH0fvjeO67ALzr1BGjkrlN89JxtHj6/NPeAXSbE+DjBp/e2Dv25deNS6/belT0H2Mqdas7NIrC9Orty6avm5BQijq92rVy9" +
+	"De96Zv/KGSAbzd0Hl29iv7R2HV56uHfl6zvNyb3L+28Dj3q0Y2l2urn71NLs/vahqzjjnlxYenQCPuSto82Dd5qHbyIev3Ibp/bk" +
+	"g+a+K+1jc62dd+C93EHQ2rx1GL7oxGWgeRM7Adydu44z+vKO9un9rZnJ1sVvWke+bV8/CpT4u+0rc/DKViZ2YNnfPru8/3D79Fl4" +
+	"pzNz7elzK1/fb03fW74y19y9r/10bvnm0daZnc2pq83t38AjevRo6eEhBH3TNzFlDy6290+0JvcvPTy7PD3TPnQTm/rsw+bkHMb8" +
+	"6T14I98C7G1fvIKRvHy+eeBp88jBOBcq0aRGpTB1kRiZMEXSSCVjqZ2keqYzbT7PKc5JOSbdJZoJs4oJmzbWyhnBxFmldL1siPmz" +
+	"KiODIiyhyAimlNpY5olIOM+uRa4+z4mvYxP+CbPmTWYoltpS9dmsYh6pHdXgcjCx1FpCbrMmyqhdrnIiDZRcy9RCPHaqUSwlM098" +
+	"tMyttSKt5krE2hjSvrgzE8ZJI4yRZVEX5tkNUeMnJyTAkcjAc4p6WQZGIninWmXCikSnz24oEpngDDOTcJk7ytTcsgAF1zAXVluM" +
+	"rdPM7dF1EDbTUWEk/9wZzaNkmYBrmaSrWBVAMJPXIg/lSDBHNCHUc+sKGSeY6WmZD6OlE6kUTM7EOz6bBYsi85XbZJntg1kzmbaS" +
+	"6ZmGlPVMSMP8T5tIRUbXcxNLkenEPDuHam2nE4kHzXIqp0wqNhj3nNLM6Ezq2EgrbSzJOMxUjiuQAckfzxyTMcJweRURiFE64xQn" +
+	"3isRfAWhKxg9J/FR6kbiHCMjLOfKLXPVNDhL/vrSGEm2qpk3aJl1bZ/NKhnrWKBmhGnU1ohtz+6ohMoSb5qD46ZTSrQj9ewGXvbZ" +
+	"rGV2no51LJXQRiKji5ERsSTldPzsXCK3Me/TYEZuCK72Epj9bVjhMtXVnJ8ZS948uzounU6f3cloG1ZVIkaf3UhI06h0AuxSiRyh" +
+	"M9qCykqGWbC28uxcLNNYlGmbYEYw01cTQWr02Q29VWTP7igmw9oyrxBmTlum0RrsF6EqPNqxrmPGU2HJcrku3lQm4NOUhcaOEAnv" +
+	"SksJnic2JBI9f3d+7vnh53u4Sn52/tb87PwMqt6fH+Sf/Ph8O9fK35l/Mv/0+cT8DFfaz87fe753/h4q2ufvzX/DP7k9f2/+AdfU" +
+	"z/lK+/k/P98eKvLvzt/A1fiKj57vmb83/wjV+s93zM/hT1Txzz/gb92avzN/t/j9/DE82/xZ3Be/e77dV/Bzhf2j+Vm+3h2+/o+4" +
+	"Oj/bo/lz81fmj8w/mr/1/PD8Zf8W/N0f+bf35h893zn/PT/zXf4Z7vqI35ff+vnO+T/zT3bM33s+yW+Na0NjAJ+8O393/vHzw/N/" +
+	"9ncMz4Za/z8XTzj/6Pn2cAV8ZuL5zvnbHcWAmecT/Pl789/y/8dY7+a73+LPeB0DKAs8YeWAJ/MP+Tm9fsGT59uf75y/+9OF4z9d" +
+	"3PPThYc/XZziv0/8dGGGf3L8pwtP+Cf7f7pw7KcLt366cI3/vMK/PcwfOP7Thac/XbjB39qDL+Int366cIG/9ZD/3M1ffMifuYm/" +
+	"4M8n+Aw+fPGnC0+2Cuxp/uRp/B++NfnThes/XbjEfz/x04Up/tUT/vthvsg03/HKTxfuhWviqS4Wf078dOFrfpLTxXUm+HZ8Hfz9" +
+	"Hn/yCv/kSnHNWz9d2MtvMV2MwDR/cv9PF37kn8zw1ab5W5f4J1/yt3bzD6/zB27wT67zZb/BF/GrK/yZEzy2E3y1iYWHi7sW9y5c" +
+	"xJ+LexZuLVxe+H5heuH2wsOFmws3Fy4XP1/cs3CPdR1mF/ct3GYlh93QZ1i4zBoMuxceLtxeuL9wCyoPi3sWd0OxYXFqcXJxB67B" +
+	"d4Fyw26+4k5WbLi2uHdxB9QdFqcWbixcXbiM+y5uX9yx8GDh4cKVhZu4z+LuhYt4qsWd0JhYuLxwY+Emnpa1JfaxksTDhfuLOxf3" +
+	"LW5fuLJwY+EHviuebc/iFLQpFi4vXA9vsmfh8sIdaFEsPFzcvXCTn+kO3+v2wj1+U6hK3IKWxMJlfBeaEgs/hCe+xm9yeeHG4j7W" +
+	"qLi3cHvh7sLthRsLN1ipYgpPtHCZnxbfwH1u472K7y5uXzi/cGVxamF64fLCvYXz4ee7cTd+FrzJ1OIUlDB4XHcvTGO0+W/X+JqT" +
+	"C3cXLi+c47fbx5/Bp+9hbnh8bi/ugDoGRgljzPNyk5/jPH/+xsLVxZ3QzQDbYHH34r6F6wtXeLz2LFzDnCxcX9yzcHXhzuLuhauL" +
+	"+xauLU4u7uS3mF34ceHq4u7F7Qt3MOP8PLtYpQMj7J9ux+Iunk88yUO+5nleI1DtOIc54eeYDHO3E4oei7t4zV1buM1PdaUz6nd4" +
+	"ZHbyvR7yqri5cAPPxXfFODzkq+3h6+/Bmy88WLi98ADPwNwwrg0CRzKhishTV5N1S2nFM7DZrSPlwMnUxjGZkJI6WRZZyJmpVhG2" +
+	"JrUa8hx7ppyYhoj5L55Nn1incXb7SgBrcqW4+lnj61AjAJOXucmorkEd5xilLLponIxTyhVLLyhCVfsIvBuhGklDibovy7dM6BsV" +
+	"cSMzKOGob/ZvZHDQxy6RXBthiKuTDb8vjVHZSkc1iWcLdQA20JVxF9BdyVipFRgyUivQWRWlnnffIxKuecTjOkrGiNnO7FvGLjM6" +
+	"yWNHnhpa0QaUT5SzxUL54onE5vy67DTrVIDBO0pxbljHggRop3AehWkwS5mlOwXqPVm4zaAwQZhGXaDMnTwt0fIQwCFQ8BUyo6tG" +
+	"1K2OJbmGr8fgsXbwykHSSpyR1SoZX3VhU823pZRrd+GsKbjuCuQtOPEUuzoJTJwvCbFQ6OTShNTlhnwtydqtOjdKwEPk6cxNRcQU" +
+	"Ck1oPJPMy+LairJIhYrpXbyprW3y68zVDChXnzBdOtKZ9LIPyonYiVFm3yFAEKbB5UZV2pwJcMOq8GdNI6E4xYAQYUnVpcX8Qfcj" +
+	"FQbiCdJBZ9PmhqpcUJdifUiylviT1hOdMEqsUcH1rlJJJ0XqqxysL8qwnp9tUegPhqS0We6IhEklV4dgaSSyCs88kzGGZ6PXXKkL" +
+	"YyRGXoD0m/qZ9mUyPiIS6aiMsSJ99USvp8Vb5nWSrRDzxlJp+f1y66Iy+coO+09+teY2x4xRJq1OCOteqioI/rzHRqXOLSKbVDTw" +
+	"lFx0wGt+qDdPh1/uAuk1w8iwLgnz+aQyxEOHnSKqJPyrJGRHnM6YgU9JxmUGKvf3f8e/eyy4rPt3fquBSZsbrE9FsTOU5DElG1Vi" +
+	"tEwSQnxjDVUxVZHnuXlSrzXEq2Fj7nRduCo5fncubbF4FKmqn/gpjgXvX18gY/EvzBW8+phSGRMmmMfaYtUJlWzo5zu9741AtCFS" +
+	"NBZt8vPgS5uSzVghIv3Y2x7YEOxqXccmGRMG+2GTTlkXUuu0LIynUtsycS0Jc8EpeYdyZ1HSo7DmcSUyXmcHYjJujEJxf9qw/v0S" +
+	"afHSm/32HSORYXmO12RZOhT8/ry7e5M3HqyBQ5Z5ppTonFmgoe4lFMes9kYryxEDEtvpDSVPuY9AOGdhDl4hH3AsiTnJMkqglSHT" +
+	"hi9ssd390BTs4kIEU9/kjZVIRvFGtia4HCMjDEhiBC5W9oaTOfyU/AqrXzUMpYLJ6gjyhqWK0zyhsRr5JYVKkGSzn7FYOJHq6kZ/" +
+	"BviaIi/wZF2uJL7P1oYaVqKWyWxCdWBV1zTiqMav/A7PBJ9Va70J4iXlqEKEl8ZmHMXatSAmcu2VSNkApY2eri4UC5RzFyntElJW" +
+	"usbbRmI/bILVtQRprEhXnJHl3NEHhrWoSxFXYPsyDmsoJsmizKhW+NCb9FrOBY9SRfiUqFSENHZTTZiUrBGJjEVaNp6eLRUfBfzp" +
+	"6vpUqOqGkq+eiEKREpj+ipLMUF3mqAIZEVXaWCcjY/FuwrXxf/Dv/pE/tRVREjnNzM4NJRymKY2DNY1V4LjyoEyppFFixS8y3b78" +
+	"zpfTWWgyRA6fTiJdeU8aquhxHG7CkJV1mQrjjVVSF+Oyntdr3sgZkckkbcQpTwBulug61clUKfF8b8vaVpRkUivytdU51F6VsK5R" +
+	"02OR09Fm74cYVCjmBFui641PvVtRNr5+DTYZBs5UKW3Egpd3jx+Cjd4reTfBiRxZqmIXf6rLZFzkyznsJyKW3jQb1IZl0RjLF4Hu" +
+	"un6MIsh+b+RTMlRp2M98tR4klyQlImYDUceSGiUW3aBkfcRUXuedoTJOXevKpKgiHXjRUlU3ewMPZdJ6Xs/I1ERm65rtiyV+B2mj" +
+	"3FKCdzeWGClRLjIam5mPOlWFP0CjlBld1w7bJGHl0jphRMedwXgHJS6mCa8nhtUSkaaRq7EeV11k5I/kMdHAkEcbc9Sx+iozu8k7" +
+	"X76qzLIInEhZNM1yaYhUVVbAeLkrg6a1cs5waWSsUe3n2HczlHG5m/XVlNaXcdq6VtJpE73yCjbemGQnkcazlGtYReYo+ZU/5r2D" +
+	"RaIscSyR4pI4X6xm1/RwkQ9XHMehDikSiqEdj4UlntQf+Rocw6JrlMRaWZ3Su0zyVzTOp30ostzkXT/JmFmqKMf5YHOexs98SQRK" +
+	"OrDOQIguDcOIp3AL+WI4CKSqdo9ReSSUkVHy69w6GeM8ylghLnaybrfUdF3YqK63wbvBNEqWZXGSGL6BNc0dzN16XlFra7lKDCW/" +
+	"SRv1TMafeSPpC1kti1NREteMVjIOVZS+nNFmRjuKGcN1bDx4Rnx9LIuMpQ3+D7G2P/aQF6nBwYfL+EPRr/yeMW2g6wTBHonSWjZd" +
+	"XJ0DN8U6EknueKpQHYpqRO8yOv59lfdaimIEbSl52/vzqSzjvl9ELMzziXcnaZxLk2oygUzNe6k2MhG+PNFyJR0l72Lv4MSp4LS3" +
+	"ZODwVAxRouubid+l24/Se96VRh2ZrkDEBPJBLF2E41qh5hJyWav71vRCSnorq2vDXPgwYrOjrEYKBQSkIl3moILlmV7u+ogrzyKM" +
+	"mEyoFLHojS9Ztj1dXe9pE3V1fSRUI/K1WZa5/JRk3upVJLtkEMmIdAWAKcsbourC6oxHCYJJsUzHpFNk7Va/ln5F2lQpepvSqszr" +
+	"Xppo2I1J9sw0CjMaUHeH/JwwFWEo8mUURijY46xmhIVrq3B3X5PFzmUqDFSLX+6KNvngTlaVNpSk2tpIV3D3SFi+uxTWcWnhELbF" +
+	"8JB17Eiw5/Byl6/Xs/5ZyA/Iejizka7E3jPu9+UHLC/WFVk/Y2WvmeULTqMx4rK8vj6v16YVFqGuVGDBWPmDDBZf2ojKUqe62khl" +
+	"BQOpTYQJNBKRReWXRnye60G0a9CKalBeU9XVfvG+o/NqKiyK97A+pYlTghQQ7rDRm5J/kuSUqI9SDWcFmiJEwsamYZ1I+WiNNvzO" +
+	"Gw9WCaNERHyc+mKVZEhwYW9FG5JVFW1M08hIPuNq9E4RrjppKEG5yygZX7Y8WBYwLNYSn6CxKEsExcLCR8EZHgnElIg+q+RwsV8L" +
+	"lcPl4EoTLnjDMeHLNHwZSPQe17pE8GawfRsZlhtOCxEjVqxL1kVUkaz4oMn5grAEC0hp1+utQNlX23Rz3LI+1bGP+4WTcQ/XT5WG" +
+	"Y61GybhRqVPiaA9rnmt+SsMY5Fy697jZQIK6J+wLnAPCsj5eaVjYCOPsy2SToV6bCTWMthhRmbuMRLoiIrZadQSoptHLlVM9Fe8C" +
+	"JDnhODNCoj429j47Sx5wLIPoSzo+7VXOJunXrKEesam0Dq6ftDW0K4DXZbArneF2J9YblGispvG4WKS5IVg+AeREYrR46GQc6t19" +
+	"QbfdzJoFEWT8MZu6jumEp1STMc4/MtYXLQ6VG5jM6GPvon7iwQ5f8mp1jmOVDFmc5ZvHKJG2xppuaeMTnExCWR0hg4XIJkeUjKDU" +
+	"epUE1AzjdLJclWzRQiVyukzsz9cF1zUhYreU+CLC1d2+EIvDiNIwoh4ns80+dPZKB3ZLbkakrbFwKpkeltBczX5BV1fZr0GWrnPk" +
+	"y1uD8sOGT2VcEybhavK0kaWC7ZpyRhYlRF1c6rT+F3nKG4iTZpYbKbAFa1gZszw8uw4U5wEPsSymGjn9jge5/LB2rY9YCRA3dWSc" +
+	"zo20dQ6oZMzwFCVwQATKh2v9wy93eZWDnn8cr6fRKPe14VhN1LHpLGGShDT4DKkEMvJreoRzZrWv2LM1zScedKAqlQqH1dvon6TC" +
+	"O/oyURtBKq3U+4nHkBKyGeAfyT6f847gJyylEdUoTSKpfq0tZTW+szMkfKw2xNXowyJiNzjRZNUr3FLErI3e9QhPRYxKeGXYMNq8" +
+	"nyMcamyUxmsc8KtYHSFy+QizQqmv9bOfBGBpbSRUEkW+bjDAP8546IoqrjTc5SUw7K+4k0600cc5+G5d8DnnlTGc0Q2ZkOAzgE0z" +
+	"Pm9hvaSNpIV2XLQhioVxWiv4itaZTV6t5KPcprJuf+fDK6miOvumjN7htBap/YDrRNeydmba6EWc+5nQueOeAqxFsHpjzjKL2KJO" +
+	"xh7dWvuB4h1nJYfj71MkUqt9KbjdyMnYRtdLL0Fx7B2hJKVR2cdqXAxXGkZptyXv92yjjWUjaqK+2ktH/NFvoCznS6/hY2y1r3rs" +
+	"emfjlo2/j/4efmKNFMdFCCpcLlIuXAcKJ6RKG9FnrPf3CpuZNPWlnFZWVsNt+MDx4/4STWgGo9Iw69CWtVGRVL/xGCd5Rxctcdj2" +
+	"qJiMqrL3E2nFcr/sVlQaMSxfbH1E25DORnpMCWuxj1Dl5yjhjaqqmDA9xi1ANpR6S5GhdEMpQcm2zvwdXCIFVoCvkLVxmuMpoE4p" +
+	"kw0ijRG8rxlkS+GLBcXwENv5UT7+GTscFelGj74KhJK5ER5D4pQ2JTJNFWDl8GJ8zFgG/yoNNkSsvlKR2NpOqti9431FhKyRVF08" +
+	"p5EXXOF6TKmqH7LkQfQ2TlhdwWnI8KJC4xgcg65GIxptoRwXJ7/iCyNLkZey2TDmrSlO+U9ldbCL388IyW+4MbV6bRSbHGcBS1yX" +
+	"hoFjCkMsuGnjig+yERjkRaBpfVVwZNcyRMG+4oao62UWvXSaRQY+9RH7Jx4Xfs/7Gp8AKCATpCv+SRosrSDIsMnDWp94b9TXwg9m" +
+	"cBWgdSEAeX7I0WrkdUzsRuVqWjX8+R596qGdd31U7sMPG/tVXjViVDrvigFA4ain2wvRfOz9Qa4FfbkrCg/jBOSEvCSHRZclOCcs" +
+	"FmTZ8pUbOG9Zt9uMRFJVBJDohlAMIeJ3NZEIb8/QMCrSla6uzbpO0SusgvyKV92wI9QY0ybhsz9NUaIe6Qor1q4ZBDpJY5FX64lY" +
+	"K5sSbaK6NsRSCoPhaQcZe91Q8nXe1hfARjUySKVs9qjKe8RF26N8CBPrIdmaJYZTHClcQMSsmYDjPHK6wuXp9p18BFbRywSt5YBY" +
+	"ujFv8mrC1LVqiIhLjEvrSzXnMtxZ2sirTnSx7FDa8Fo0JvaBAzY6rs5+ndrs0wmJcLhRqhsidY2Kjy1g5MeEJR9X2RxAE23mKDKq" +
+	"+XX9KUucKNYPsG5jCjhF8GHDqAfCK/Z9S8M97IYmiWY/q+IHBPqrka4ADxGu9o4/8TZ56Nhr3tg1/rDJyAQ3rWK85ow3XcDkPvCB" +
+	"ZkVWHJESNkIzth6W7uzhtgQqqXg5eV22cW7IUCXleu2qUDLeEH0kXK2Hg2xV9cd8lHkBEBGxrrVWZIw2DRIGRoDxr6jMYYtBZ7MI" +
+	"0TOO+aiG+dMVQ+zrG1YlCSJHUFBwfDo51gNOdZ5UDKAWXYk+YrCiH8ku4D0qQuj/toeHU7I4TPHSBNF+3rcwT7Y0zIX6lKDNSnf3" +
+	"sNd0iFhohJIPPe79PgtCRdzxqYHGQamrNRhtEqkXzGDWEFwMUSZAGPwE1YowdTL2ba7KXpNIG+fWGq+99CtDVeTOtHJRrLPcWFaY" +
+	"y0gYC3HgKM+Mh/Qgs4kpxtlIiRUc5/gVyQIAucHJleg454GsESkvpg9lmkruEajIi14MvyeUE7aB057dqa25oeS3Pu2Bx2VIQdvc" +
+	"kM8pRgwGxJSzcxF95ONpX8/fRQgmlCsTY2sj1Nik4cOOYxHhtK/L+Bd9/P+w8AxwqYTSRuSFxiI+bXVFK4p4BFNtog2sL0zGq3bY" +
+	"T7xT6sgY+JGO71vJGZwe5Z5LVa/FFMWGY3xwo2rkVbwp6LxY7DECSscnCqZKxNgfVDbCS591o0sM8AKhsFl8ZX1Uowje2JqeUZGu" +
+	"XsOg0ZpBFg72Gw+ntrc2yKbiODISunxe8shCfnXNYJdHZo1UEYzdJva2rVefit7OOdAMGkZbfFSwSWS8vjNKU0qqOknI2i2iivW5" +
+	"kTfVehxbYwKHFExgJhzCanSgjKTy0ge//FBiSNUYRdgSm3w+7td5IqSt+3gF0ArOKs5MRFFNWDxLrjiAY9XwV9by4pNKaXw67eIT" +
+	"EnK00CdBtIGoMLgJ2jAU4eWtvGqYfZuMEob1f4yzmFKYZr8G0TwzkqomTFnn5j0PM21F9to0evks7+nx4TFcE5VYmGX4jh4gQn/N" +
+	"SCv+j/OcxFLE6RsTZYJl0mD2lOYOZbzqjFaNekIpBwQ1bRyZPi8LAfdAqKDjUer9+2jo57/fxEFatEEzOBIhdrUu4tZuSeS9X4Qt" +
+	"tnO82MgHFx8J52o0xsnB2Hr1M7StjCrapGIMXyBhGR8OGClbtyjyUnFfMGq32gsJ1qSq6LIeH/NHnX+xJJZObiP1QZRo9YozHhzv" +
+	"ifiAHhvrKa0Z7CpzQFxlxXe9HuMWSdUTMXDm4SL7pz/98YvBMckugK7YhvLDgzypZU1SAsKJxZ6JKm0xImdDGlkvYOcDlaFY1zfH" +
+	"2mz0KLgPc3t8NOg2+WiQF2Qp+p1Ma5TWbV7OTdlWSeVSUVmytm4PtwRY7cUQI687aFknRleUriOTvslnsi3F8FFw98hp7gaQNro9" +
+	"xNPtRRaHelM53NXVEzG8KBWUvGqJtAD63w2YOOt8RaNS4KkhB3tbp2OUVoQhk6fsPRsG42K/G+EUWK1MnsIQ1rDp9YhXT1y7wS+i" +
+	"1UE4jXFW6zSLvEOrORPVj1i5JPrXhHxTI6hBQbwfF2MsPXmbW7xaVu8f6oVTB9+GTZYla2GFa7KOVYMwotyIvOpkT12yOXQ6cmjr" +
+	"iNdy2vb4Po8Jgw8ZKXglfn2tl7ygGx/6TK+IeCu8T6bMe9TIEbKR10PsCXKPLP4XUt+ZTfVY2ogYSTORt58RMM6ul16SLpLKcb9M" +
+	"SiLjfSLOjUeRcNzEIhPSsKUdoUi6f+09/43KaSW112uMgjxgOCe8JKF1nFxPSmvQSG01gwEppSRgUbyqWnjotZ941kB39zB6DEbA" +
+	"xDeUIn+eScVgwJBPY3hlm38aHvo/em1sAAEhI+2ddZsKNDQZ84cw91gR9ucboldylQAO5ba1xqWNyGvBrN9oRFkKBStMCRvF3DSg" +
+	"Loa35Sxgt1cMXCstezlGWmw8HJmGkk0pRk7FmhFdUeXz1oO3rIoPMxNykf7EI5FAi3uTjzC9eJUd9mErjwRQP0v1cuol9SJmVlDi" +
+	"o4KezK4vRf8Y+c4xrtyIrK4jzqmTibzeomWRfUo2CYUkSA1DXWO3gr0EYe2GEg4in5NyUqRvi3Ij1crnx4KZYbgBOVjHKbiNlt1X" +
+	"r0RnsfukwdbVjqxIrY6kel9jWtRAQC7jGN6FFwmNqlpgy7zn3bQ13EVvdQZr5mzkMVmpol/nacM6KA/Bw0WCE7BrXcD+ZJU85UiY" +
+	"EmkjTLF55R9ekZnzHnHkNdRw2OBxvM6eTbCrdIVz3iIIgUZDfknB2eIkJD65GpJtXS+9hOUSSWxanVDSE3FejRuYsEQnReVGr9+/" +
+	"5Sp7PqkYw4R/4HtecLMRSsrePRjIxqNXs/Gt3M2kh7siRcIrqPbUiT0Wv567NzAmN4j1iaOJBWN7/DUjPiKjDV67dS1iHXSm88KA" +
+	"iSlAMqNEakWdImG9ZmnCAoLGa/N3RTiEtSGvXspkc2JoH+wPaSPAHhhyHFc+fzjm3YqxGqOwyHVllJTNMPp9eiwn8hniDXxI6Uqc" +
+	"I7YnaRk/EzxF0cakLg3DveOUDHr12+gjz1H6BOt6bQRLYqSGL2XJodUGTKUaFQklG0rc9sRm7LjwO1gn+E3YyLL3y8aYux5EkUQw" +
+	"b8nJOs5NDsRyZ4WTttJwOqqwiOCYgu3RHKR5oTTLi08BMFMur8NtcjV/FacTyaD2e0jO2ppH5lZ78UzgkVmkK57nEWEx4FBANIGU" +
+	"q8HyjgPNIBNxR0yuG4FaJOxmJ1RKDa89aXv9MbrJaOGkiLzeI1ow4MUch4NsICjhFk6RWPNHVzN6rEbsYKU+1CMHSkj6Hv+/kt/T" +
+	"JW7xICIUeeiGhScVSQSMlkj5ZLCpewuWl7kFgyHsEY6Ec0veTRtm8iMlFeIOux/7lIFUXg5Y82D8TtdJvWI/9mlVFpkUitMJiAP4" +
+	"dCw32Gnzcr0NeFbcHpdPPK/L+Ue4/WSs11CNtniCXA+PJEWsFLaa+zaVhnNo6JeGo80AlAFajPIRYKpkIp0RZhquL6kklWQjqXxW" +
+	"Lop8OgKQibTR6tI7v/lok/aEQ97nFW18mBP9a0/b8JqCFoGKtuQ0jt8x70IPejfNJcFQsw1RdTnOmIehWFqME2ccePW8wqeoiLy+" +
+	"b/I2KFzWjWrnpcJYK8zHxQNVxmKyTZ5C0hN0qL3sK5MVakjAIu9YETGng2K+Cp9tyFREnsIF7oBFqFDD+mN+Fl5g9ZpBz4XbUPY4" +
+	"HwIpQ2LDhgiwypBfrl0sN4pcFqdqeTMG8dEe9tG1wsFOSdTjMUTksBDZ5A4D6QViI69VGImI+4xpbyO96F8P7CAvMWZobfJovfVK" +
+	"wHhoWa1hkSoL9wDBjDf3kfRLGOtEUmJ9/k9ELMqItVuTliUMQT1hN82nrT4re/egNMytHj7wzrqXAF8rIs7h4OvC0Mes/xdxBxAy" +
+	"6Pquc8eXFoUu7phm3Hy1FzH2XgWbmkhE3X4DfsK6lzy07FYYTJWPkj7z+tdrmWfBtFOpk7XA3H3+1uNqmL+Ek+uB45kSdwBMGxs9" +
+	"EQW2fEw0RryvwUfwy13YTZREAtQrrVReJyPSTZ7gyGC/tPwsUmnDjgRQm0hi26+NpIoN9yvzEnndntdsfxMjVYZmDQjS/A6IUriM" +
+	"Ur3jUQcCqAePxdaQVzNagAWldephJi2SDdIxZ8UQc/g+CtRSPlCCuKH1i67LB2k9EqEjRRt86OXPzc9EVNU6iQyNpKIuPBVqLTzq" +
+	"mrSf+QXKzcLQfwQjsNaz7WxseGDKvPHq3ENOpF4HPvI5vgj7jI8JC9/YayhHfp1Z9iZMw4uk28gniT7x/CWyDNt5DnlS4a6HSKxj" +
+	"d3AaXiqw9KxkhV8gZh4St0QIErBlEPn4xDTmVKYpd9iLhBfvjLzidw8ujRPsQ4EnwylYzt37sgoOE0fX5QbSaz7qSTTZRLJwdJlp" +
+	"TA0OfVLhzzHLWpfOYo9VsTyAvipDldwWutLRht9uxnc2+zSZl8W0nKOV1uePemAtIl3xmfa1KVk41jZnvUjeTmnqnadeb1c++8zr" +
+	"sr4vzKgwSS/3XPI2jn1KoKtGc+TGnaLShh1p2NiI3yoJB40Tmi93bXSpUE5wCjv3fbUaa7OcLRNz30rDQQWTuy1GQitGw0XEA8sK" +
+	"9mRqfud4IxBRain6Y5fnH1nlWQpreTPi9AI6ikjtd1TGqkT6XvCaeJ+ihC0RkxaCruQf/aIFjw6YR4W5qRgQ6azVKZ6z7u9uSNEY" +
+	"uDjOaOm4yZZ1/izFA9QBXGPstfmtBdNlCKcTHGsjVCxtt2eJ1TyyJ4zDkyH65B6MhASCjTUcXuE4lsGpjXiJsxhWenJZxR8Jb+eV" +
+	"iki1l+ccYvC9RhWD+debfMog1sHPYrwu9pk7DEgiGorMqM6tV8ePvkATLWTYIlyIO+qIFKkzlTa8Hex1OcFhTzUnwjfrVNeZ/4s0" +
+	"YHc0JKKa8e0WSu/8Jvr4N1sifmlay3MiojFhIq+ZD5je+ROWQ8mXu/xh31Pzu4OfEFkwifRMCZuzNKzLqaxSwlvU6RKOh4rkg93p" +
+	"6F2QtaLMq7lLxZE8bHNNWuFqKTn6Nbp5kfk3oqb1zyO4U5REoCAw/Rd2OrKepSciboT5j56M6uslwnEdlSX3ruQDdkMpEQ1+I8dx" +
+	"9adcbrHWK0BHXkA78nyUMe4DuzZiIFlEmcestDfw3X4CBz31yIupR14V2fMraCzzZzE3aIpY9jxyWkTMp1iNf9QoAAuDPvdNaluj" +
+	"Tt4/w1Cy/f+jTwvhKUXWEBHvKyA7jKoDs+/iRms4AxlL/z/yvr5XNwnGDCPBTIeXXvJsVd6ZNhJDmzd9+sEnWz71BBavtt7LpHM9" +
+	"/nbjg2R1aZwLQO3aMZ/mlIrPsUhWotVj0svyR95BtdFvFTIVMLE1wXLklLDnHJUbroZQS1V8osMHtkmvF+L37bnUqE7ho0gVIcsn" +
+	"/IYt+/T9Bz4Nz4hxVSNhXyfrt1HkeWRDHrMQg5CFHur1MuiW+VXCec/BlwAYirwGOO/v3pqIOGbPPLv2fZxHG4CQYZwrZACn/JNg" +
+	"yt+G3/9hcHUl99rktneY4XAROxsJ+4EfF94PQz/3CSAbeUrI2yS3MrPKifTZd4mn7XNFNuWU2xFhRFWQTKtkR0nZEeGBN4FQS2wl" +
+	"VD8YqZ1vAlNHaXCss2fnqtyNkuqZIZY8rwsdu7wMX5QpwrgElj9/QPheDlpxuVKoiBfIfT67aqtGxFJY/zvha6i0L8e3MVcQJ553" +
+	"IdSz2ToZLeKcTIIqYStRKE31stG+ityXxGvfSYosbeWYiH3img715lxVlGhRZYF6UlisNtNcpcXdGclaGvV0VTynfjaLVsyazw5t" +
+	"fWmT9kVCvoUTV50YkQiBtk5SU70sTFXXucZd+4pxSx4M4rZaZCtUNmQQfdKz29oxTQctoxJZ1Vg7IuF5iKVmaEAK4cG4jAyqp6q5" +
+	"MIkw/LbCBm0B/EsSh/loSCStdMK6ZzdcnupYKx2TQdW5SjSDAVt1hc8VwdVW2vrfCWbIaesr3G392blUVIUlK5/dUUybclwvKxLh" +
+	"i/J9ywOrq8LEUC+IY3yS4lwkkFfgUm8uirA406EHgFjHyKBMYOth3lGlf0PUsW6U8EPOogx1bXVFAs90EC4gtjjPZhW3CNPcEKyu" +
+	"LQ+rYEUA5vuDa8Hdsuu+bTNiMWTrkUzHv+HkC5NApVvIqgplSDYWjjOANO5BF+5RbEiFEruk6GRD477shhF56QggKqwkpspxZj/J" +
+	"rfNOpIzJhldOQyGd3dbNbIf1oezGWl1B7Q354gVHsUgJD4iLsn3zJSM2VOsk8F/xRSbu8vtKrtsC/gTgMdOW17MBboCKMU7ZiRTB" +
+	"PbxPTzoiE2q5bCItF9AhltexTgW4+yqmULtjRThOQy2d5WICOBqqweVKomyZXck1emQZlYYHXBdVsU2qUNcgY2Yj4znxodww5x+Y" +
+	"4VDIJSMABzcD6QcQ0ZyusikMRTlJmbsHaQOYB5FGmDdG2gDk2VCd9GGYZ4/uxGTI17c5411v2FtwJOo6YeJMUVjgD+y0wYl1VNBw" +
+	"d1eDbSFwGFQw3KOoZiGGb5kVyp1psF7IJUZneLbMcJ6JfDI2RptIPi64Wk5wBykufwrVnVZR1SvRByqaUCJtWFlUiiU+hVIaztAa" +
+	"RVjfPYbrTzG7IuXoTY6SMFXuHIh4H24Rd2k13OKyjvkH1dRKreD1Yx0wF184KqobAQKkMnbIImI98nuRdSE/12D6lpDK9zagZIOv" +
+	"AwG2uRq4hs+hIKrwvRGwnnMs/YRZDg0hTWyAlTJURUkAXJJE1zGycaiesoFontS0ZdYECDt4/ljDLFsSWYZzoBaKOn1vmOEhkYic" +
+	"YTJfMFCKgpVOvREl2xuKEAMG32CaeiVPmWUNcgDr8lOCdWNk7DxTTKRxVXaXpeoNBWfFPvTLDfuPvDMSntsK5tPJmMbJMEDEfDc0" +
+	"HU3wktgOvslbHPPQfBT2CQgDvMG4x4mqBoBvOLDxk/VRUXjquB07GPzYQJsL++G7Ahgg65hn6wwni3ztMyWWYUQmorIdRV2tMCR8" +
+	"oW7c4HbxWrFFxie5MWmFgl3l9clYRY2MhLvEyLHf/zrFRPvqsATrdlQwRuS0KkWeIR0T0rncGN+XClnfkafoapI2RJpK7Fefa6SE" +
+	"u3BnqJVLU3JShfWRcGd0ZnR5O/0vPb2pJ7CQ4uEhZ4aHXBJzFy5rfakXoaEzQ238tCjxDqxbbl44SuZ9XSd4ZqGW2oYCbQswEyil" +
+	"p0xgHusa9ryoGwcgIFVOPjlWGoY3hlDIl75K8TtfNxNFoVTxw2A3YtYYih0f1ty1w9s1RD06LyKH4a5Q42vZP482RFwrhdZ+ypc7" +
+	"+c4EUngMPg3seEpC0brl885XBLK9+LSw/75OJgll2xZ5HdiDsjSuhvyKw7+qnmslRRqo3DY2ktO/4ZxpcEKnTuYT76ilTBhBwTn7" +
+	"b3530Sglvj9K73Co87ZcXtIVRYGyZkP3ukZR+RYY5Inv1YX971moIIfCzosEqL6jTIywpffrphQh/PIMPIbrhzkElqPELdv5/2Ax" +
+	"LVUx6UqbwHLr/dCXbkS+j1RpuBT5/kvRar/vqkYkuF9AZbv4/DYx4ErRsFL48wOTINlODoY6O7jcSZ76Yl3sa7BCwNIQDsWdcSg2" +
+	"9r1D1ke/8KVKllUKGJOiVPr3yVNksoZC5UjgHNg49WXDHCdJVR1KJa9rLmuqyJj7dnPhHYCrmIY8sjfs1x/wRU8L9SwHWgMAF+de" +
+	"IEpHdd/60MWIMC2lgUe2nvef1CYwBUqp3MYrLMCI0ZoQNoZiq8T3zenq4s0mqsTdj+tC+W5BvcNBRcAG2jzDCbBJOCdR7RMQxQbO" +
+	"Ypz3W/OEKx5DCXYP0tC4ThQ2sK9xpyQoQZQQNonhl7t49AwWsnZcnsHnJ3ogCT6neiIPiyaSySqRL32Iqdu7f92lyFeuBmIUFxHC" +
+	"/AXqXggSfPUj9pkhHy6yncmVC1XJOubCfK4x0qqaNtZHr3AO8BVPBnqF37boCgc7y1w0SkLtRuTP1UrjPRETznwGgXVuiyqewIK2" +
+	"oXxWMauBCf6jvD5+h4JmUS8Ku8xnQR3D24G4EfQDAFTwzq6kNA6/clPwk1MxZng9+ecd9n0nucMVz3f0VyWz2E9DvZwlGg5yFraG" +
+	"cxkJdl97HXEfL5wAvj7R9gZQoDc4wF7OAnbHuLwaCpM2lEq8DkdFOiaNj99836WEO4JJsj7rWYqCHU1gXa0v+2O7+MsapanMBlEt" +
+	"/45wVApROmIH9Kn2fLZSVIr8CvLMFpRFfp6T7/rIdg/TBeg26DxYDK/S0gZaY9dLQVMkCHFYC/c1EQ1ASrBvKFdlH5hzD9yxES9s" +
+	"fRUK74NMW5FuKHn6zruBJBP8ROu7Z5YiMqE6PAkYZ6OeOV232nDVaGLI16eE82UoS8MB44m9NoiI9KTkyR+Rx5c2hOVhvfkoDfcE" +
+	"ArK3e5Qg2ceVcHlaxRHVE9JQ4fmTUMIeheJZ+66vf7Rjfh3a3wQ7Xwokeb/duK+lEU6boHhiRfCDuEZSquo7wW/iAFVq1RP5YvcG" +
+	"MxrSSqApRXUZ12RVqKDREXHFUFki0kcc1+BsKfaH8fYQ2TCApt6KoUqR3z8K3GMbiMJRqCOj7rfeen1dd38JlROIJUPwbqJCq4M7" +
+	"N67vCxkEhxgJazkIgtjuUIOEn2EcA9cn4jDuxXlvBYPiMg75llKwbz3hvEhCqbKtptpaYRpdXRuZoIAeSLBjDB4NuYSpEB2+ZmkY" +
+	"6BLOpSAwY7npvrS1rVoJgGhMypauEUjsPXEqFddy1oSEHQ3VitYXS3a95It3sC682k5A4wed9syMoV7mUQ/rkRR4gAjFb8DjuKq7" +
+	"L5SaBgUE+2lYt0H7IolWj9VkXIt6Iv9+8ENgx9ifelFsbYLEShTWUxQKLEpBsCIK+iqWrPcPB/2ER4OhOKtgQ3i7JtK1Yf0VDPoe" +
+	"XzpRqosql9wMB3ivog3Fwjqw3iS4Y7XcAAZPOKCx9Eu43o4Ga8IyLY+b1wrnu0Ty83thlEAmLdg0ET+WSHQofSkBxyhTmg6FdHio" +
+	"JRwaCkmUzyAZk9k/BekQG8QjimUZhcrOiNtuK0r8666Phsoen0xDOVQUDG4UquZD/bId8lhqVOgsBCUWWxfG1gIpC36Vj+/Txpog" +
+	"RuHEOJdWoAwEvw/P3RU0JyysfP7smvW9mbfoKASqfn293CWc00ZRg+pZTVhpOarWxlaEihtgaGqTJq+A3ZQmqNQI07WhkMcJ8XY9" +
+	"Gx8E6bs7CnXZQfTHhvdJRoX3111N1zPuguzxAaR5uKjKl48XZrKP78Pr27Ojh0JB5aYC30lJmIoc7wobJRxv0VAw+EEmw3J+DLR5" +
+	"LwcTRcGxCu5NxCXmuSFO/0DaIKjBFLWInAnDOvK6P9Wi2t3TOiiJgoxSFA31JnJ02PtByOP6/nvYNOwf+eJSj0vgUf2ytMFu9fiu" +
+	"nR9wb1KrTQNBQfF7Uq4U9JLWcJZE2iioelgep7IeD9IDWDdVJRSCXm2MHgvcoA2wdPARtwpkfC1xfhA+oI8vh6ui7m/oy5YjZEuw" +
+	"38J6ipBUZ/YrrBDjStn6/mw86gkfCNUqNnCNB1KxrQHLqoCzlcnw+HL9C5uBEns7peEuT9Qa6g3hZvj9+oHeAPQF7bAoxK2Rz2yX" +
+	"oiB4E9LRw0O9gUYWRGS6VvtWcGtW+2Zwa2pmVDg7ImXwG42uP/te/dud7tkjM/LsMkEBDspuDJE+u6qYJSaFDSB+6N0tbEDCrRIx" +
+	"+1Een5Q6zKMOaH+xL0RVlyUZpQOMbwPAbUcFwnkpYIdwfgKyyZ9dtYn3t/H9Biuk+mHQ2bNZhOVaaaud0cG/kCLYCeCmqVZeS1Uo" +
+	"4FEAo50QIb4w5LVoA16GB3FSVIuEgmV/69kdjjNkXZq6ZETeivqzq3B8Rxkt0YbFwxKWK0UAqX3BqjChCFNnKFgzglPl5tlVy0mL" +
+	"mCyFDR6SDZa/l4gkfnbHJLostgkjqnqbKBIjnoDLXUQ/z8kr/OD5RPp5LlMyIWXizbEUNuC+3FkTnwv/FiFpYMP4CdaOlaNa4B/K" +
+	"EWsaJ9rgH3iekIdAHC8xniFZYW3OzBPwOjRgdoFBVFIAl8T9QorD1lgxNtHctZ87YEv+fYWMEvg5I/Dwa4p4A+MvrC8wIBuyN7Ys" +
+	"rAvziDEUPg4TJuAx2qM0+DciS7/+jHB667M7o6QYj3ZGOu2eXY3hYMRabc2V09xT/tkN4dNRwgiL1Imwhrwciq9OFaasU3LPbiiL" +
+	"EAHjw0vIhe9pGzI7wF011jOLcziRJMSlp7AJ+IvIzLM747KugfTwuHF5EZrCY5eRjbVX57Xk0x48HyrROvPjz3kEqU3wd7XTrKUr" +
+	"qiLl6xHiprI0ARcU7NfwvmKCrBB+mdrYPLsBIDJ5dicVhoI+hhQho6IyXE0nWlFMFtQCDD7iBfjLz25rjE8ZSAyUnZRI+Zc4D4Wf" +
+	"f8RdGEduZm6qwpdna3bOsLoY7yRDYT5CJueGGJWjWC+CZcC2CSsSgmPKeDdX//lEE9gpz27EMvVK2cTbt5oLK0bxtUS4Z1cRAHXU" +
+	"fe2zc8AFt3F+5tltgfyIVjIOCSiPtxGfBNjENuQXrS+ZFElsnl1NpNP+PQTWP1aq9iW/FHJd2obxEUF999b8vefboWSL/z7f8fxA" +
+	"Rwf49vP9+HdQE340f9x/rqPcCz1eqOmyzvDzyfC5h3xNaAv768z4+0D5lj8frvd8R/g9dHfx2cP8NDPzj/2/8VlW7H3CerwPn+94" +
+	"viuoED/2msasCvxkfib89yHu9HwCzzz/hO/Pz8V6vlAYPsTPPhXuPwN14KCafDdoAHvV3ltB5/cWP/ttvifrDj+fxN+fH4aiL/7F" +
+	"b70DisvPD/GT4/3m5p+E79/j791+fjg83735H/1nnu8Jz8/PgefDT57vCPfZ/3w3j9N+zAY/BfSD7z4/4H/P18F47vfX5/fc/3wq" +
+	"jNcjnp+Z+afzt4Ji805WQb41/6NXK56/xePs1Zr5OvMP8K/nh1iv+A7miVWP74TxexieegerSz+efxK+/yS8763wX6yeCR7RmeeH" +
+	"/Lrgnx3AuuF15JWiWbP5+dT8DOvA7vJ6sAvXoM66OMm6sF4x9ir//BZ+Ar3YxT1Qtl3c59VtF7736rrwAKDlymqx9xa3B83XFyq0" +
+	"+Oa+xZ3Qf124Cw3WhatBS9drzl7mb09BZ3bh/OLOhR8Wbi9Ms+Ku/98NVo/FVXaxRu8UtFoX7i/MLjxYuOavGxSAd/JvcH9c9SYr" +
+	"AO9aeADNXv7+zoW7rC4LreBJaOwGJdrL0NXFcy3cXrgEjV486eK+hQesLAu9WFbT9c+Pp164FlR8by/cWJwM77sdCraLO1ibd5Kv" +
+	"92NQ5b0KhdzFyYWbQbF2H48o1HC9xvFVKMniu6y2i29ex3gs7lo4zxrFe4OC8GW+942FH6BFuzi1cH/hysLdcI87C9f52rt55Kf5" +
+	"2zdZe/ey171d3LdwtdDhZXXe+14zmed0u79/0Oq9tnB+cfvCfb4uRm964XKYlyvh+S7737M6MXSMWaeX9Xcx5pPeK1y46dV1/Vz4" +
+	"5+c53b04GfSbr/HMTLL+8DT+5udl1apVq1atWrXqZ6t+tupnq3626m9W/c2qv1n1N6v+dtXfrvrbVX+7atXP/uZf/O1/8B/+R//R" +
+	"f/gf/O2/+Jufrfq7l7r+H//xy//Jf/qf/icv/8f/j66X/u4/+8//i//yv/qv/5v/9r/9b/7r/+q//C/+8//sv/vv/4f/8X/6n/+X" +
+	"//V//V/+5//pf/wf/vv/7i9/+ctfVq1atWrVqlWr/vKXv/zlZ6tWrfqbVatW/c2qVat+tmrVqp+tWrXqX6xateovf1n1s1WrVv1s" +
+	"1aq//GXVz1atWvV3q/5u1d+t+rtVq1b9bNXfrPoXq/521X+w6j9c9R8VuJZHWY3ESe0z5L59gseK63VUADXEqJApUBYWV4Lj/s6W" +
+	"d3rHWYOJKWVSVVGmiXpPshoCnS+qs8l4zAuXzst8jJMownqA1yJmTz8EzEO9HrEfZmkOOPGlKFTrWOlyn5bhpCUoejrAWh62kGnD" +
+	"x3NeszcFJkRBhIISwRWCOrdFjjkq/mK9rrAka53JOdEvqoY4g18qMlGZRoAGZzXJfYxb5JCDspowDVQvMVLYoU/QOAgdUPgFps2Z" +
+	"NB+5vNzF7Gx8XzjnhbfelprzRo0vCuJlkX6zzGlDAL0xBJa2YFLYBIGs0bm1glVi3IvkaFAaION5VkI55i2A0i9VJWVENqhhDfV6" +
+	"rU9IF3rsMW34pKY29TKJ3Emkf/ETXMpTp7ZRUtwiYck6hbox5SRz6lwtr5eVkOnHIZkW9VR0nNvVawbhv/Pr1GXVz5dQcGriQqKv" +
+	"NNzlFVNY1NVyNQ2k+RB+jpJnsiH8N6LOKhCODNxg0/B4ouwg5dCdFNYCm/Y5amnrrFWDzxB3WSdDG1MaZ+heZB55SwoiimXediys" +
+	"C+polEDAN5Wc3PIw+zBXsOL3nlxMyvUWQCPKezj5ENi2G0rlYpY5czcmLWVcN0PKgcr57LKQ9n2dpo0xrZG/Ez7iDkQcO9TrBX66" +
+	"uJoBt3+HYo69oyywGJJNou6LaXSWaVzYvh14PgD+KjkuONwVIPYitCz0jihJNLGsWMSoEMreOcyr+K72gQtMwBwylKv6TEVXQXmw" +
+	"XGWDyQ35BZF68VuMZsEDCOUWpd5Ayqfk3WJbZcJY+kC51dYFmcdchXrSoV7/yF0+TamV/Vg7Yu4gceKVlAtKepS4MR01SBjri2Kc" +
+	"5wpi7VCQOi4Ncw25Eo6KPGfyNjOWuSra+BS944Rg5HzMAJAYG8CgtrB4G/sugqkyudpQIEsOF4nyhlQ2Z4ZuUY866AvChWn8isJK" +
+	"QCjE6d9Ym0wbzrvXufDKFsSoHqmYS8DyZ54DtKmwz4G8lNuCZcNyLtI5ooLvZLkwAtOpPKGcTJmqXmiTV42X3eVS+TJVjE/XNIIk" +
+	"XNqQiusJKCoSxIOeKRAoSj695uWy04ajuMa6KfgCr3LJGauoTF6HU8XEHFFAtKz1hFd1lFJW04qQ27GxzqggB1lmNTlRpTUF3gxJ" +
+	"Pr6yT8ixnlRdx0jFcdE/L79x582FzUNWMKDrlBR0G9s5A8K9RGqFTHhFSRehuqNc6JQP9VaMrvOViyx1UtCrbJE/77K59Rn3kMGI" +
+	"+mwmDU6utMiFd9VlbDQiNZ8lT9NGUNcAnoyqWVEnL2EmRykAXltZrY1qOk2CDDNsryc5JTD6bLGDqBar1/oJYwIEXtCLUyOl71Av" +
+	"FIpWchz9vw5gYcRMe3yroNclIq3ipK3VC4KTNQT8XGrlueGKODeSG0/WwjYosi8+je75MKEkjYl9PvO0uqCKeGqadxi8eP4aRiXj" +
+	"WlSwRZLuIi/6HpUNVA2jIoEbFZlEUAS9sasYwbluHL4EJ8DFOgVSsaHkV2YsUhZa4PM0EOYi7w9FuhJE3Cl5H4Pxr/FHkTUuWDZR" +
+	"nQkK0taZvoPhReKZF1uBTL4UIHZKiuO4R5uQDWFDFAlDrqYtoYqi4CkGYMj6OhWJIN2nKjkX6VmQWACjsGmJV/uVoAQEQLYnCLSs" +
+	"DlJ2Io01MhdSswYASy52OE/4BMNPRRYuodHgcHQXJpIU5ihyWqRhbQSKGzgKw150qkiaR0FblpKPAxwcFafVUAXkBnhWHwQGYBRp" +
+	"VU5zs6HEWwawo6FYZl4LxLIWWfSRNtyNa60oa+9mBHXfUF9YFwlRXXv3wktKq7ihhDGe5ZeMwiKSzcYD8T8OhjFJpNlQSp0pBYYd" +
+	"2YKy2hMV5MjQ5UKbgi1mQ7qPks2UOX5DkSSckFpdrJEoQPTEpS2shRScUlWlVJTZvG8uzDu0i6TLuWmWY3W3QtUtCdQuY39NJrd4" +
+	"PegecKGKN1/Q1grp1FATF5MF4Vko7khQls4Iw1SQKnw16Hdz9iMbHwxCjjWC+L2uBLpoborsGxUJvqRMVaG8bkMwTcjdSfBAuQsB" +
+	"nsdXIteEeifQehrB9nJjBUNg+gaN9bThKWnMefLcLOsLu1CdH1i8PYU5HaowGy9ymnd3rhIL+XHLwlIskC/J8srEERQVrBlm5YyR" +
+	"Lwdkhxwen/DEUj+EUa6CBqDN9AgLbZSiIF9XJLvIy7OwpnSdWHmq4C01yrCcSHWIKGif8+xgVcICKDc81PuxNmNUlUIFdhFPU5LH" +
+	"gVeL6MSsLpgU8Oc15itoAIvUZ2oxF5/7uMcWx01P6JaQNoroxogyV+rVCAwKP8s1UlzL6GspIVfvBTtRwVz2NB3hOyvI2OOnka5s" +
+	"fHEEMFOIkmIl2I3S4NyNyde5oS7Tq1KMEgrOMcAUxBqkY62XkNnCCeZFY62TacomLskNoTcFq0OxdUdZlpeYECoq+Le2rlOK85Qs" +
+	"c/Br0rIvyp64cF6oNmHyeU3rhMvlWG+BgkFjafRMm6A2Cj4CFxBx1Zan8PoKMiiAxCLMMtsWXEcV7qXna0pVfbc4l1lADQuf2/tZ" +
+	"v0TrHER84snlImWRdMw/bGYMDy0z0mpFBi6N50d4aSvQZX7++02sAVkaDiyMzYFcFgWVhQ0lSzivI13piT5gDSXk2vyg+YIHhxSs" +
+	"pxL2eA6UJ0VJ9r6DKHba6C4CVO+zsfPvgjCOVtVuT7OpBCcbuUIMQpXcJq4m5agf8zXU6xOLw1wCVQ/FztJLcnqP2nqlAXYdwQVm" +
+	"V43tvXKscoj0nqclSRr7nQzOW6wz/6Zej8HmFm/Lah//RIq25ZSK1V7AIUVFlC929uk7xHWejyljFB7kmG4wJXjZ/E6OyAyr1XsX" +
+	"pDqSag3vdYwJTJOKcdIXeehAIIN/6LWaheMEGp8yrI5kdD2sVWFCtTv7dSzNm/iaK/hjFPQCh4uU6Afsj+EVtnjZUy52ZSMVCpTq" +
+	"lou5cGC8B6tjpBem4NMcX2Q7BcieHwyj4iVysWoxp1sKt8eXN0jr2Ahj9SgxKqvC6YJBVRqGp8Rft6zmzpLAKb8zeWVwCP0X5zqe" +
+	"OCHhan4puprvfYK1EVRVIO/uk+CR1/WEnIawvuhUREEDPIUdsyyXxNCMrvwmLNooSB9RwklAJ30fpzoOjiTPUt7nTF+x7JaEbiis" +
+	"h8Ga9jhMsWv+WHAxfSk1q/RV/b1CSyeyISHpCPXwTKhhrbmEDKslQ3uSfP8Z/nqRJMdJ7kQ9U0QJ215Myq8My/JWBUdJlqjOv8Iq" +
+	"pyQSqBrEjGnFEriYOHzrd4jRQgU4+6Kej7ylxv65KTpFgYGEwmRQ5QOF1nodtkhXNhW+X/FeSRCtJuxFYsn+oYLZGJhtL3eFMC4m" +
+	"cNkoHAFMShgWXKjvSEVR0eIh8EzBA6mwj9RdUNiD5CIllsWcalgcePGo7F1THJpecxiW0Veb6wrT9m0k7G+LSCFEQFqJKlegOWId" +
+	"AGxRVCj6QxJGHRcPWulrCwJIaTjci6njHtYjL/ooUt/Qgm0C2AZekxFRpdOcB4YPu6lmsGqFKioHotTXnUtFwkpsZp0V795V0BQ8" +
+	"hoYVVcB0WOqowlWMMzEKwRITo2S8bnokFVQYR4RQFntamEgUDQcoYXkzkNxFQZ0ZqkhKE0vhCevQ0XOGyTddXUG6OGjpOe3LcgBe" +
+	"hboJO1xUEBWecKB2+1ZRecZz4XBg4k0BwcDvFoG4l0r3wuxIA8lfP+Ds7gaVqk3CyHKZMATBbhRVInZMwh+wUhVIRVFKu5619MrA" +
+	"N7w0urQuCNhH/UO9HhQcDp5W2vAatliTsPw4tgsCd1RUtHGVMT9qgbtEXqsqcjohkfKo2gIayi2ZjVVPkPawj1eQz2qZsEUtiaqK" +
+	"KlhGRRHG2gqNeYiJHQaRWl3wlIYowbtE5Qb2Dvew84IF8BjZYRghFAMkVFfk/RYY/ALtWet1jiJd2cwtvvw5CNCcEi+UAQ+4OMQL" +
+	"8rQd6vUzMOyCghPKoP2GKAi4toiJki2FvwpRPTaVxaRs8GoWoBTj6K/rjmQqJVjPLGpaVBpEIgooUYFRNzqk3JhNptKugIjsGCWK" +
+	"69sxcayeFRWlfsV0D3CNPa4johddyDzOFqJjbbyMFNgxEDcU1kVFugFqJkzR6vJ+nUuGvb8RaRVYjcNdmaQ4eBdQPkVdeQGCOVJY" +
+	"PkTeGcDBVpzZBUlqaCgoNwz7+mxXo4Knowqi9XBx2vSxwAWb91Cjt5aBb229A8cCm3DIWS7Xd++C1QoFlWsZj/YyjZ46HRUY7Pog" +
+	"e502NvHxKRTPjpffRXU7xgjLj49a/Irdb08R9ArsXhkDOjy2M90p2Q4NHj1LfBgSxKZr9FFuKYfzn+pcWimUWF3gYnWJodNOZEUZ" +
+	"pojCjL2D5ACu6OWj+fQMLSl8wxY5SlBV/hOOIy5G9w5Vbjn+L0XsbmwosZeH0Qh8VtmpKLJB/CHWBeYZFcVBAn+JfKkRlIDyNBFc" +
+	"DY8BjwLlMmiaSsebFdy4qCDfF/si8mo0jpSnxwdeI7u7jIXD7vICwBO+XADsG4JQZk9C3q+mgnUeeTABVkIlEffCGeoNFSdhUP0K" +
+	"dzVDxDV4eAZdCRYJ423e3/LRh0MixK6N9UG7e1MN4GCwkHyYsBIZMnhFOBB1F+zVcNBvKPUW9XsoueHSGlGcTcyNE8pxMTQnKVKU" +
+	"bdG/O5enklw+Kv/dnX97gP7dOSeV+LcHw19+erjrp5kTP8183zpxsXX6+vK1bz1hr3njhGfsNW+c+P8eP/3/uXfj//rq7tLM7NLc" +
+	"xNLDqeXpc+3pE+hVf/3Y8vQtfPHKoeUfvmoenGidPtjcd6558srS7J+Xtx9rPz7amnraPH1rafby0tPbSw+PrOy80twz29471b7w" +
+	"pHXoz8vnDrQunV7ZeWX5yOPWd4+Wn55amplo3bjYOnZzae7r5vSZlWsHlm9ub3+3vXn5WPPo10uPvmkdv9U6ON2c/bJ1f2r57sPl" +
+	"icnmvm+bk9eb0xeWp68sT882D8y2Htxunr7TnNnR+vON1rEnzZNXmgdOLM1eb87sQKP9G5fax84u39/XOnNx+f6+9vS59pHduPjT" +
+	"c63tN9uPjxZ0QiuMe3YjzlMN1w0LWZSFiSnVipu4M5kocMCSDknQZuhBj2gEvhbytmKsCGqs5Np2R+Vns8jpcvW5Zwtxp3b8JUQ3" +
+	"AGM9ezDQT2WiC+fNjBZBVodJCOIVSH0sk8JkKKVH/U/CufyCztjhRVoZAC7tK6OZehfnTL/ztc7PbghZf3YO/kXBkhWmoFcZzrkI" +
+	"I7VA1gA3dZQ+u1rRCuG2tIGT5UlkvpjW34J5clip51JppQ3159oWBCobEDMpyHGqQthQs61tIGUVXWtFomNhnp2Lna8rkfRiDGVg" +
+	"kiUiwSjzqGLj8KMWbxFY3kxXYxqfSEPdCysvmBhLwatWIkoXqagLFYuqVtuenUtpWwEg64xSXi2ioPHZ4JZhCRiDb4CJd44zGaDk" +
+	"JsLXCPtFkonwrYItaAuaZDHy2hZNtXdwS+ynoeU2mo7v4MbYvin4DP95kX97j391iz+2p+hE7puUb//pwqnwK3z+Ov95Cb8KP5kp" +
+	"upI/5A8fLq4zwY/hb3qJH+NK8fULicxSnejYhhbgeKRbP13cwf3Lr/BnpvnvF/jvP/DXb3Fz9Bn+4YXikX4s2pb7u+8ubnGr6Bp+" +
+	"v/jV1E8XvuKf/Mi38490vLiXb1J+HVe+OBEeFX/5ht/iAN/Rj9iPRYfyr7jB+d6iT/kl/r8Lvs86X8EPwv2i3/kkf/cE3/RGcZcT" +
+	"Rbt3P5K3/qpfu5+4vUWTeJ64MM47frqwPbQ5x6/OhtHGC+7nRuzHi0GYLO51omjcfoFHo9Nt/WwxUDv4w/55jhQ3nS5GzD/5k2LS" +
+	"f/zpwr6iS/03xb2OFEPkL+hbwj/lH/rR/pHHyq+H68VE+9fxa/VJMaoTPAjX+fGu8NP6r+8pHmx30R3/SrGe/Vr1s7OXn/ZG0cb+" +
+	"VvEWp8NSCeM8Hd4Lv73Gf54uXvBicS9u0h+m8njxkN8V//Qf3s6vvCP047/w8K9Wy4Xi8S7xM/u/XwsPjGc+wK9wv3ieS8XSulnc" +
+	"1D/qTZ5cvlF45omg3yHBUAE+TL7Wif2Vl7s2FTyWaKsYFb5ksUNg4c5sXs1iKCu4OZxY45Zr8IF874YhUfzWK0rChIW+NAN9/bjy" +
+	"Zn/lggRky4ZEEpu8XoY3aQkVXDXNsvROpD5QxVU2iVRWtFFSBL0NyUzoAP187CEtyUeA8r5yQLOlLgAvqZXHR8VfvflQvaizKEWd" +
+	"JHbRMdF/Q3hSSegyEQmbQ2g+euW3G7tNp1q+w0KyDglCaYtn4UD5d4I1iJ1+Ab71RB0nUXIFAb4BUKFskNHw+V3W0fTRtuwo724o" +
+	"/aLg3LPOW3DkgquA96Ux69siB80QjBUStzwuoZVsTWZbOjP4iTCoMfQMsEwwYp2nxVz2cGD8m8rqEkLllLkkIX3N4xySsNZ34ENc" +
+	"+Gknq/hZhxPG6X/rtE6UDiWxQ4xg81VIwHVlPRNX1zbjaFYrZg9tKA39FcDJwQUGsyZtD7veISUmtdqkVYjpOLxC/Oswad3s4XcS" +
+	"49ZQlvtyziJl4t9IZvCkU13Vvup6dXEOr2XdTBF2jyk6iDvnHYi3OzsgdJhECkfxBEhLq7lRC+SrOxmoUqGyUooCi8HJuOPS93bY" +
+	"Kl1F73F+smoOgCrOxgdDqJnVZKqtzmqNIrEbF3l3L2cHMg3XyIeEYJJ1qB8QCvNKrwWcSIwTlOFsNGqNTHOTWNvjs9Gr1wyyShdD" +
+	"2Mwa8WuSoNGJ932PR4jHtOCglVjQMgsqMEEfI9bG5PyzDrqLMUW2xPlcqBdTTGQ95BMjrTZ5IlVRw0hJwhLe7CLFcZ75adUI2Cpc" +
+	"zoUaSfw2MzqwzEI9uPQKCQZXdx91rFQ1EHNkXOpUMnEXOW5r1cmx9yZUzH7cWWtZrqSt4SqUsvaDryIO88HdbIW3EUUcz4aE1yRX" +
+	"pfITdELFpNZf7DwointWSaAfxF6tk5eBjSmlMueCf9Wxk11d73CUGrlOntAiNPfxH9IxXJ/kApbjULdbD3uQkQh+j6FORsDDSsCM" +
+	"PqaEDHdEK1ND+zkvtJIoCVWB0rrAJPKKJWEveEUAUqUOO+DlrkIXZTAKHB2u6eS2xag6ZW1XQ6LeIT8VuBa0ljiDz08auGgvd0Ud" +
+	"ykfBx4gij6Dxu5WD7i6r0nm4iEU/efwKzQJSn3D1fE6WLYhP1IMrMSpB2+FGb6wexbwQzoCKlIEdVHKOR1anMol+wXAI4xlBtSFy" +
+	"Gmk7TysKrMRtlHjCI4AJVB9zY0tboGxpw3DWDa+H5/aU09pAsTaGRMFTXd0BtVlVFOsIJxi3j4cOUSlopychzWwazHTjPWg6lrry" +
+	"Yv+Od7Q5PB2ikqdhjshY1KORUjKvM72Ec6t4Po9PKN1tKCPhBoP+B4Bdr/glRdrhDYUu4FgeuSrQiYLQFzc60JyNNVPOMEnS+m7K" +
+	"BvvXOyZhjbM6Rxp7UJq1GXmfp1SVIZGNuWS5+74Ot7ggPKUNmaa5zx5WoHjM8C6vPjb9n3hrJlW1v/PdzAKPxsnJzCzOofhyUbyR" +
+	"KHPzC2SeNfcCiLSCjCvxnukQjm2RZ0gbwx0SocYXucU2wDfDoyvrzJlVzupK5BsRrBW+eUSkKx92PIXfqoKHCRzUJxu7OtRn6cFH" +
+	"V6NNukiMMhmAWz184hufMueOZYGQ5SzYCNHmAoVf61UeuB7W03KkVi93bgK/xxM4nZdijXQF60s5ImND9j4QyUxUwFysq5f8fScb" +
+	"XlCXY5KFfEDqQV/4NMXuHurt+CWvyGhD1DcYyWiooOPGzGzy5JcO+dd6NBj7rVA7ShtOe51eZmX77v7W6u4g/vtFV2GxQv05fBVf" +
+	"Ub2NNYTI2wOQ2PnVhsOekax5ESDJj0gorrpdW+wF8hwErkEH4OwbZ3hOBqDELR0v0uZhW4AjNUKs5caER5yEBRbKuGQUMNihTirT" +
+	"Uw6w1rhHHPtXABpq5BWOQsrbevQWxjWo7gnT8IktPEtoAQx12E4uqXPG2197y4/PFfy1wvNgdSMYGub8YBRZhOalF2sSgBKP6XAn" +
+	"AOlw/i3DtpwkKTI8ZN/p2LAOmd76PGXOfWN8+SjGJArZBexzLzXd4RK/xIACs2CCewrbSUWf0hdMpqD1zbPDVYrY3h1at0/ji9Tq" +
+	"Dts5CmwYV6MP83Gqo1NUtVAaEakwFPmF5TNLyLiVvDNcWjMYiBRjwrPRuJM7l1DUpdtQ8rSJd4Ea82/hCxSWM20EChqiDp2FUzfv" +
+	"2INOZYX6zV+dLoWN7Qo01Ze7gp/IWr/M7JXb2KXx+yhQ5o0Uvl01Bn9jZ4VVQLpg29nVSY4GRghD/rH2Pnrt1cKK+pPOMqsoDRGa" +
+	"pznhb/whtuOri/WyusMKtr4RBKxCJw9jh1iTFi60P4KQJ2H1FHxS+8gGK9FnQfB736mJT79wC5xWTCxj72Y0kM5D/z9Yb5Y/xn1D" +
+	"Sco2SoJcOCwNxJfZU4RlVRrZDF5/vrd/QRKKWE2GxWBBWfdX7vBcBwrlCV2xY4Fw1ROiJ6ESxUkD+BtjBtlcFZUbHTKN7dBC+2F3" +
+	"EiPGRFpJtXDrU6o4aaPc987r8M9f0Pqt76SNE+mdjnddJuu8p5p1YkT4Ap5kwP6V4oLyoCJJSacgIvKkMewyWDhP6kNEKpBDEUFX" +
+	"LaWkU5hiWY1SK68G6k/75JNOLJ7KYuVs9pED1Lv8D5TuhpVnh8l2uIkYC/JNGD5EFbNHCJCM5zlKfCNGTpczQ95TNDxfK9nY2bWd" +
+	"DG/9Q0/m1KbRobsOdIpW0H04+DmINIln0I892vIVfjnuZsly632mJZaJVJSQSH2noc0d/z4o+amYIG0WTt2gjlFCwjfyeQIIMIek" +
+	"tGczkafQMn1obeiTxisxkDu6Mu5WCTaH9+GQee7kw3tyVWjIdRzL6EVxlYgAphsu0gh08BLoQmiA5XeAYs9XqkKRo5O9jDzTHryQ" +
+	"TgFD5Dul8nHgVS3UhhKzm0RUobE6CeU5Tn79YPw8Cxlslg4zYngjutJza7aosyS8jcDWLDQxiRWOJet1dFCILo/5I0/v2f+4x7vc" +
+	"MTmiarVD/LaGewjBgnzSwWQ2dbyggkJcGu5c+eXeF5QC34kp0pXurdYmI6+sWTMYuq5wbPBC3Rb7EzF7+AnuURBTbIeDHAmm6JOT" +
+	"cYdbaeuM32FNfdLBgqSLQpPOTzpYgVcSZEyBuIV35HRoscBklTHuk1ijDrG4w2enJIj+9PX1QRRNR1VRZ466jyADZwD+OIuthNXu" +
+	"CVaJFcp2WzKyotUINdiGDBZ9V9e/T4V/6gtFuAVwUYwS+Z6wHAeZODQ1LzytUeqQOqIOZysK+pTwjLzSI/ATS0VEyplVGAmw+zxp" +
+	"qVDYeeklHnugIoV/pXMb9O8kWS9Jp+tk0GDCy+AlOYVOd75lM2YVHpnX8qNKRRtnA03Mt+MK7eUip7cURUprkc7y/vOnQa1TKM8Y" +
+	"MHVK3u14BSMy1T7O6+zLovKPkTHy3zCIXKukdG5fVGQiFpKJZM9I2UyigMZroFpmMSWB1SfwzAl7bgV3OOwAXDzuxBCo3QGOtKFU" +
+	"RPTwRjLWovQxLEc+ovD+R8k3dk6ZIuc1dtOGj5QA9YScnDANHskYT8CxKc8Re5G2RuTqQuV+n/Gx6A/mTvi5ulNOtemv4tAosBx4" +
+	"hbGpQpMGx2GbUFGQbCpoF2mjs33/sUN77Qp9iUWVcDZ6ZLHQBopEKSrKDD6UlDtSQrmuDvPOE8axQsM0MxUjQfNcT4Qk/5Zs5flv" +
+	"qDj1fGSnI6HYgy+IIq/YoLnLkaap+/jXV1LkmVaegVv1ZbhbPUutw0JnHWwfp/R0WGRve0YEz74jA0NgO1WV3JCU6zLJvjh/i4q0" +
+	"ntg3cQZ1U0W+L5h36LvHwpr0/sBmJvHjzYM/JIXqrM713DdUg5cXuqFgv35MY9G/AS1OFG6JlZ2qz6RzruZF3WjBzYlFCiIiH7Ci" +
+	"QMBHidl/iSzqcFn1bZMnQFW0KapaYzIkksZmpp8EbjG3USr2hy14JEw39CUdcFn8adXxFNjvdIgRUdngeVxro0KNydda4NzvUGVh" +
+	"R4LoZNDwxvyCG8LHe28HhahSgbdzgsbHYJR6T4c6BcARKsi8hYOcY0esTFbVBqczJq7ydOncseurK4qq2pPE4R/EwhCZsFex48EU" +
+	"7vTK8/GMtzm5QTxfKIbq0L3FMyq9tRgrqk2iTlH12iJTo6qFxm7aYHodc79xD9/2q1OhbTsktegdb3fBMPakwv6+PqZO881DC7OC" +
+	"E8MKxaFjNgyyjsKtO1UNTL15Dz5XLXCGheooqZeGpS1cmALFEWmIo5K8HhrJYdNHhWXrHi52f9ShN3dqbHuEkaKb617Ym0uFIhf4" +
+	"RWPCJp0S+dS30ouE8+rQsfSxpF+J8Jid9s0GfLGj58d7FnM4BCPtyZl8MnWYqZ0IfJQ8dw4+poW1ZTl4P8toshlaIUfwqdA1BWtA" +
+	"Z6T4yhVDnu1JiUh0FliQnons9z4vVKE8kRrnDOdiGADseDe+cSK3sPO4FJ6q3GB+LCaTHQrsA24+zm1KfcEUfls2BXU94KxC+fXi" +
+	"rWgccDhu3GP9VcKa9IqvnmyG1a5NBESTw9tE57biGz37fR573mxf1E91/G/w7Q4m09sBOHCSIFjiADJkJHo7qgJoNuP9QtfBCjLp" +
+	"nC3nplrr+OgvF7qxqyteXxMNHUO/WUb42Fd5uSvScSw82lT2TPxIeo30UBLkuxukjTC5QMUZcsMcbSps+9oXBScowcbTsfI3uzq5" +
+	"7UgQRIG3aiMx3DnVOvXpQwE0q2iDeffUtR7f+q/U2wul85AjxUAzzbFQe08bEjFYLELVo2fQJ1FBNFSd7EOmC+XDf//9vz/676/8" +
+	"+5P//tridlZ5u/P/OpCQFQZhJeJ98h022dIwPcciUguMJlY1E4nms4d/m5dl+Jz3vWRQQ2MmzQtmkO1YVvK9L0TyV6wuVHFy+Eu2" +
+	"Q8yyhgqVMka3+B4FhVdq7rrCdytqzaTGeR6eRZcD+apTdk0d8pZlBBJfsD4fDwtd+C+dnh2spuUzxpw1z7TCeeSV9/1b8jPHUoyK" +
+	"NBUJshhl6BQi9Bae/eXpVr73htQdPpotdOjIdkhLNlT+0TZRiCpQMS6xZBbYszt1nN+FuAQZ7nFSzWmbxyIlp90DbSxkmzEGHfgc" +
+	"+myJH5eKIf4g3jHIKhA3ScXDEOsV3WclotteXer5jvAz/O1HaDDNP3p+mFWUZvmne1gTCbpTt+efQLNo/t78k+d7/lqXilWRZvm/" +
+	"0LF6yjpTcx01qTsv9Ki8khPf5UGhnfR8R+e30MCaYW2lGVZtus8aWPyk+Nz82aBdNTv/YP5uUEoKClFe2+r54fkHrIe1079lR0fq" +
+	"YVCcwt/wfBM8Bo/mH4envxu0px52FLImOxpQt8JoQC/rkVf8wif4CfGt8MzPD3RUnnC3nc/3sMLU0/C+d5/vgW4X62iFvwV9J6+X" +
+	"he9BlerFffH0T3jU/TN7RbFHrFp1j0dyJoxz+O78vec78ZPnOzszMsfaX15D6m7nPe493/F/my3M8QxG118vfG7mr678sHjr5zuK" +
+	"30LNy+tTPd85f441qmaD4hi0sB7Nf9O5xyzP6J3wvE8wpkHRCvPxOMx+oUo2M/+omMvnB3jeeAw64/eE73uP9cO+Yf0sjMmF4r7Q" +
+	"M8OzzN/rqIFB2yusnOc7WCXs8PM9YS4PP98d1MN2PJ/g/2Ff3OI1CWWxyc71bs2f6YwGPwu/SbEibj3fxbpmB3iObuFaYZYn+G2L" +
+	"z/l1Nst/D6pezyfnH/lV69XNeNxn/N+eHwpr4xGvozu8dqFBxs+Hnxdac8938tw+xtt01v0jfm6/do88n3g+OT8z/yNUtBauLdxc" +
+	"3LtwESdFUAXz//UaYg+hCuXVpbzuF6twTS5cx2+hQgWlrcVdQQ+rUBkrvnt/cWrhIutVbfc/W9yxcCd842HQ47oB5TJWkNrFJ9Y1" +
+	"/G3h2uKOxb2s1gUFKq8ndpe1p6CsxWpWC9cWHixOLe5buF2ohC1O4j1wlcUdCw+gqoXreS2zhdmFqwvX8K3Fffj54i7WtfLvCs2w" +
+	"6c5o7AvPdzt8bsfCvYV7eAd+jsmOphqufYM//0Ohx1a8G79NGDdWF/OqZTv5tw9wJSip8bthhG7y++D5oNK1L6iA3YMi2sI1VggL" +
+	"Y7A4tbiDtbd2L9zke00GhS587h6P5OTC+YXbeKPF7YUK3OJu/zZeSYwV265A26wzC/jcHdYSm4SSGVTHeOxxx7382/NQRwufw92u" +
+	"+5EIM31v4Ua4Gz9NUDYLYx806S535vg8VgZU4wqVs3CVaZ5LXn8Ll8L77vCziCdYuLlwZXFyccfC5YUHGGGsxIU7fNXdCw8Wd4QV" +
+	"5sfcr2Qoq0Ej7lrQPsNc3gxKeQ+wYuzbjS2iClWk1b7VCGcI+1+QFnqqshJ+6s9lJr4VXa49Fy4NQXfpRRasUzEG59+LfElV9fwp" +
+	"vhipUWk0s1NCjo1pMYBkM4Mgk3lF9UTY2mCnqhxlESHVONTr0+AcSQIzC+nukPwvWGvkGGRmZL/GTbJfZKPXd2S6SsN9Lwg+5EUI" +
+	"EDkEgo8n4lgXskKbgjhPaIcT4u6NAdFkWlNBDWEd4SxEkeRCv+TVuUlXB/pFHW/B6RDrOT3dkFrmIIRTMlFC3uWXWq15gWS9yLPb" +
+	"nl9/8qs/scbWnzpekiOfvBuCmPaLTM/g0ItsSS1XiSEOu7q63i/KJn2/GOlTbSTMeiSVB2PdYTL6/suRz7yXKYVbv6HkRTX40QHL" +
+	"/DMJeOUdoRJKspp2QeSoUJAB7JC6QZtnoDb6xM8oGdv4mKujcAFb6DLJUaqLcV+/t6Fkx6Tb5j/xzovp7qhMpVDvD6Iy0VBv0bJp" +
+	"2NUY4StLkxjqqF15/hqvM8xUkWcMCJYkC8KI9T99kYG1XS91Ip0XTJGkqHjjTlFeiUySLXTqZKHTCzpB8saLJfcCAx9ksJerklHw" +
+	"XLQv4THzOGkR9VdwRQ8bRmXaWKlyPsJ5aDgEcx8JV0NnZG1W29wwFZFZMcpHbGXybbKCIAZugow1MSGHZykZekEtYmTFlzxKbhnK" +
+	"EJpUoxxoRrqypqfql/jqNeyGc6SVvkM2k6yeAN3uzph5kJkHdVwU+VbfXSn8FMCH32QvwAUPR3CQW3QE4fLdgpZjyx2sMkJn+bDJ" +
+	"3nmxhwrdqrQR9bwgAHmiqWdodniANqQgOeUdWGE8UKoReRwo6eBMkW+8xB9INNMDkHL5iNtERMgbDb0guL6wO1Hm22TjEkWFDBuQ" +
+	"qBBzE4YhDjwPI0keqCqCOXSMC7gaKvGQlOd/vNAbTBvcW5BkVblG94tkH8TXw8T6Nq28OjD6aUopamN1jLHBSmQypWRswLMRmeT0" +
+	"KSkhrWWzHXV4ToEShuXpe0mEBD5TCMIcJ+SLN5mSYk28odQ79ILeGFIchUhLWKkhUkVmfai3k+Ar2ifCPsg0DeshFAeyHBwOqjjk" +
+	"DkK+gCLfpJrBwB5/oH2sExJVI33/O9r4wu50Olga65NgPFkfeVQvYuNd1M1Gq19QsDG+IekeRHb6+/r+lVQdzYuhF5QHLvPELG8o" +
+	"renpCAl19rxWv1FFhioqGvZFuiKCKpEkWzaev+PLDf8qseCRM9Z6SoX5/yNT2A9eHOMFLZ3Xr4/YuS1nxJadWT6djPcLw9X/Av2P" +
+	"fKscvsKawaHesNg7giCUeOW3yBKpQCAVNkJ6ngvzhQcwmBVeGn7B02fwNbRvwhcCv+HnHbwF3f2jcHYWbfCANIZcCuO59aAPkbzI" +
+	"C0SBQOez+VYHyHqo9loxLbDV0hNATCFZUG68qD54UQ8KExQVyf8xLzDo8bhsuMsjbCL+PJfhYOUb8TNk3LGI93zBP5Fa/ZFba4BL" +
+	"tr44ACKpPH1ZVr3MRajVSNgogNEDVksCs8a6Nx0iflFzxguow1zv6WjipY0P/sqYu6jQfAyFcw3GTrkiXyhnQ1syfvu4plPBJHTf" +
+	"zC4wuj0Uw9RRU+TOsN+0YWw+G+8kd9lseqxRRB1FG8GSO+z7FM08gIV2WJFRQkDGvEcVuoV6hYwwJL4nnI25EZYTnewZecIz63WK" +
+	"Du5ZJOg3lFASG1kusy+6jXqCUCX0FmIU20831+F6doU3/ty1Qqqo4OtYzTflR/cpA5QcF106oZjDrFwuoS96/jBlh5ul8zh4BVIM" +
+	"9Qebf1P0SWLxG21UJNWLdFbk1UVYTSQ07Kozhz7qCKhxxTIvy/UdqcPVWHA8JMoVrPHCEngJod6ttmi0IW1UFESzWhh5ztILXFi6" +
+	"KA7OyIujritg01hcL3cVTWiGAi14iz83M+ntTo2iIjFXtM2EfEzBUOBmcX51eYdIefqhFyvkl/NsLv6p05EVDZ6Ed184ZSLqEJEK" +
+	"jVQcdeFnMdmgxYGvvXBZbdE1Tqrq+77RF3ZTkY3n1JiXBJVpwyuieLprIbeTNoq+YK7GR4QTzKusBPuO9Rvoe9I1ar7UIWIjWVDC" +
+	"bNG5tSazonlPpBVvf++U2Rq2ji9xF16qtCZchZNk/OOipxi2NJMUOStVekEzZk4BDKhdzxJ6/muuZrz0X41esLejII8b6dxpPvE5" +
+	"38saB8Wjc8dXr4brSavI7gZJNamqNe9NylHCUf5BaEhXKvy7oV6pOjneF2RJXjCh2KKr66/8yY5k6ouzZ8jXLrw7ypQ136qrRlEp" +
+	"cjgU3YbSZ7EwLN+mc8dt2XSlk8iOOcuHzzvJv9n04nQqamOkqvpEFS/Pj/KOE4lDSqoqhvjFYTkAix4+ELxX/KsuxwshjzK5MfLi" +
+	"GUWjNyyNjtB02glpRyl6QQItEgTMtg1n1ij1vrPlneh/hypAEGvFitC8bVTc8Kw71gqMdCVQxLB/kxf++ouEflQEQbmhno7aQMTC" +
+	"E/xoduML5wk2KvT/KShWfIqwKnWVlGO76fUp1r4IfYpSF6mqn/zfEIFQnGcLNVAZxDL8jUW5jLPBp6WrBSJgOzmi6EU0HhXdUAs/" +
+	"1fJm4Py8dxcLsjcfX8UZ3F808Y505f0XRrcG+2pCdVpULBShgm6RCZ4CGzGeAL5Z9IJj/ldEZ+s3JOtyxEJ5Ia8yvajPs07/6qMt" +
+	"vgfcxhfeNhzo4K2824EM1nIEyA5lp+8v7oVlWOaMsF8lKByrsVhLjX2xohorBK/kWYsfsXvLi5ZpJ2GgOqwZ5YX92B1+waxkumHI" +
+	"0NvYEPkj6IMX/mTBJoT9JRsz48JZYahI+HXi7tG/quWz0Yu6UrZ9/nT1K4pzqh3JG/bvWeiC/rr+KGK6jY/R+IUChajms+Aw/Aap" +
+	"XamEckWSOhZpudGZ46LgDDu8po3rTmHEOnZLDAdPF5vAp6+lc8EL8l48zjmRMpVE+opA8mrELB4gXMHxwJJ74XCuHertnKzk43MO" +
+	"qzsW5tUPXsQXLypELccA3Zyn64k6yEjYol7KteO1+VImPr6KntqRVExI9g7cX1U62rxc1OHwLfzWC60wMd8dYXSUEgeRPZEW9XBo" +
+	"vyvTNHDpC5Fn70xXjGYegJWOOqWTcGwK1pNHOCLBHENrZZbJjjJ/ip6qRQfnMte88pOxoBCvjDFfT8ADUCcnQtfiLUVNiEhfUOft" +
+	"lhehUUGiY4WeUJEqY5l0VsmL+k5bFB9JVSXRiRYN1bGwMLGhh3ImqvSCEvqPHW6cVB3dfUoCD1KkVvv/8trpVP0xjY21LSJd+Yhz" +
+	"yOyjFHLCf8UFk1pJGzQYKRl6Uc5YqA5yOVyn6LQor9tGSdE7O5JKBF1M5jMmUWAaMWnN7xNLBJOqucEhghOet09YoUIqKRQGPEgT" +
+	"MZrk/T7e0tgGWjkdsYsWOe0Vh/jpYlaSZeHNQihVpo0POhVBa19QEK1jOSMO3GxeLpyQDi4n0g6oKdJOjjhteO0kUyVeRmVDYsSv" +
+	"SQ7YhHWdbhORiMbrqbLrdXUDmul3isj8fwA8burUGgYRcR42sBQrwjeYwAgwgab6gsXdVTThgE0VNgoxCg8nS9CKgtXB+rd1CmbQ" +
+	"o2p84rwo0ouE92Bg6RnD8+PLbrPlg0uoqKgZeQFVDL4o5V/rA3Xm8DgdjRBlfo6LSmcLAxhxD3TrV/L/j693e47jOPMFn9kR/B9y" +
+	"2usgGMZFtM9unCEaPUFRks05tqSwqOPZmHPWkejOBlKqrsJUVgPssR3BiynqYlozcUzb66MZSRYo0rQuvAgiKIpkxHr31QG8gW9S" +
+	"hEde/xcb3y3zy+72PogCGtVVWXn9Lr/v91tvhkX7JDvxvVEjOpmmqTDgCi0Ky4zBhSVFyDOMinE4DeQybbFlx+SbiqIsmhI4EbHG" +
+	"mvsBWf19MMRxKyrk/9RGnQNPX7OG0BoINCJ2LrME6C2CPBTjp1NO4nAqf+EhdMV4vpsMzidSTQU8lmkQGboPYySVDOzoMn2O6I9n" +
+	"QMdQowpsnwGEXMoRjpdV6ZaRIrCDDqjpuaJAWlcCiZLdB2urAk8AZla1xQSDaFDg4U6wMS4ZhwkjognQJQjVrFBxPtalmVMS32+w" +
+	"xpQLVCN3bbvLi9GUVUPvhrF0P6Cz1dYONoUBVRRZM8QKPQxNHSK3+3AL+pdPsoENskGLWi5sr7gu0ODjGh1c85WJtjeFIrDPtsj7" +
+	"hUOijkBI9GkoMVG70HAkktdxAL8b8EuB/CBkMUSWwFSRYXrpzBLaPFgo4j4fVXEagwSNVckoLPZ+O6itQBUciUHjuEBDXdlE5jcH" +
+	"wvy+8Ku1Hw2xQp6OOs8MqWZ1XDrX5z01FcBi/GHTYoGOrZ2gT9GApHcVSWzYxIKxsr2uu4ojLsKY4RPvKeqbrEV/qKlJVRkmLcWw" +
+	"jC951Bf6dhxZ/aFwkg7IZt0RKhYr93spZUY+Ga3jwI0pxoQhJ7h25MZnrQhkd8ctiNQ/yAuA8UPgLWPtq/J7sAKeg5MoGKnIFl1X" +
+	"swqQW8yb1NWQJi0qHItnSSeknGR08mJ8wJrImw39y22IPKO+4eIC5D9H3jw0hWCq8zQQ9COGsaBBavFiy6LiDh0+jFoUQLepBs8n" +
+	"r5k0O3i1RKz7lq2pPjg0q2PqGobtM/yUmbkYRbxRe+Ril3p/Vu9oqPgGH4Vk4EWKqi3i0qPCwVNNNEbEFzO+FN8UYX5wmXlx8QXh" +
+	"SMjQmOsu2kZVOUiEAPAdPsejDAfA7c16VaJlw6rt8LQoNoKZRjoEqwFhWtnQ4thKUx0LjXna1vAYdlGNLRP7GWSVuQbU0A5G8w7Z" +
+	"Uym9w7c36z6IgrBpKpgP3LFsFMK1tt9/hljGnOz2634D1yXHMjkgSJlUflNHuGOkMKTUty9HYZn0s3GWYJfiFmUiZneddloqPpSN" +
+	"CUazkAhQ5KKBSctuwhNtaAn6ryBmEQvjLEd/cIQYDkqxV4og+1K4qpt+t2TLyRP1NJIXVwNrIosPeoCYfYmZptWm7MN5Th0lCGrj" +
+	"sdyG2yvpYYx4k/UGG/SoLtUs4RDZd6taImWxjBIO4QDL0lRb5dMpdCJK5LAYUqgnMczMs/ZBzQuHLSz4kVNFMRZP+Sw8cnwJOMyX" +
+	"acsCC4+Fi6PMzpEAk6lwSH2MBi1u4ZaFr2EskoNntkiJh0N/o4I8FJPw8YkzCNlDuYQDooY8ubpywh46xHVX8KkkQVzjOBAGo17b" +
+	"vic+Ple7l2Q3imyZ5ThxOcyLEMuo6EP6UsaiMqIZYk0cAjbrQlYFEeCE5JCiqykEX/guLSpT+IGzPbL6PMpwu7L5ARhYjMjG4ukU" +
+	"EYhhXDhxiE4VmYOD7OCwJnjcwA6XKUdHLfzIoVKcuxyvgslKYRODVMAEwCA9ih4a0DXJhFFFWje5Se1u/DExVs2Lso2BSWtF+qhJ" +
+	"TiYmOGKxqdinWOPiTsL2H0O2q+MtF33IF5LFn/id5rlKwyAuWrieApOww6dEwI/7GRXg41/QdKe5w8VnMEGl6gP6jEWgvAsRJgKn" +
+	"Px1Jrg62gfmNKxYX2RmMb8WX92jksF0Cy4mtFaKnwfUGg8A0BWzk+7KpUp8uwQVEEzlmDxXOtSFy52LWi3AQkfKW0+q1D5KgZ6J7" +
+	"sIiJZ4JNSyEzW8QV60Lj6pLlUNj8klwEXNAj45SWZA8GNjhXcvfhBQhNLiL1LFRyUfxs3Qdb4x4J1/JbgOkznEwlx13uB9Sc0ymi" +
+	"ReoMaDy9kHx/4bj5pvkxxqMMBmDEBMJkyIYEKIhmBrdBqQtB1ygWXIgqENlnIDlSUKwY3BckoaDqnxIp8HHB9H1ATnZ+k7Iykphj" +
+	"RpZACQ4KovqSdktQtndY9jC0ay4ssVkHTjs7TD0XAoVDAimaCKfJPBqy5NLgtRSkgB9tSepv0l5fcvie4lwDKXIAYBR/iiXFlMwb" +
+	"phA+7+Dg+yeauXmkp+8bWK1p215kHoigIy6+PKQgQk2q2mGyblgMITpMMeHlS5waFFFmJT5sut+UnCWvQuwSOlfgx0OKKMwGg9Qw" +
+	"rs+2BokFoXWB+ha4T7rS2ACrhaPT8Ma8I4GNWNHOQb1hVmEuxsNy8SRXaPhSzBlSxbEF04Ng+IYiLvC02mHAxyZ5LQrNk1KcHZXo" +
+	"EuC2ssn7Dr0WrslYmUSeJdtcMVuyVTHjBiZOyFs1FvWxeP7NY48yjUaJxh7LM/BevciFvDAnIsyxN4608jY0lQG6HkqDYbqX8kMx" +
+	"aIbrouDlFHlIKI8eGtNUhQ3NKSKnkZzaug8onEG7PDytcfXQ4KeSLGbaQXhEuRbPzdMpeY6NpDgpF+U2Xpvu89Rq3E5wvpHHEopq" +
+	"05X/92Vf2lQVE4Qorm+p+1gWP5L2Jp5IbxvXwwDRHz60NH+5TKaUahaLsAv8kfsBtMNT/UmQgps6OBJRwGOxqW1jh7CMq9qt/eFD" +
+	"uKJxoiCFT2PTXUgZ8RGpAicW4/S83agont23fTYt4Ype7XqeHhGJe10II5aXD86iI4z3TbUxgeh+elxzMup7S4U8cEEA15C4H/GC" +
+	"tZHt2wJF91NdUag4V4r9y8lUFwaUah6AYv6oqWpqji/XXOlRN174I/9wh/0sHAD0bmmECHDrep5KkRhzQ5Et/LG0m24tjgWS2vRt" +
+	"v/ClE/pvjGYQ7cPxtjkeGcIUwjdAuFj0sURqAXaUpaVI1NR6jjRh8QsR7+irEnjrOFVxPAp3rvuNvtv0PbeA4TTcMYXLTAFMpQdJ" +
+	"OTfhC5ZUuJGJM6sNV84ZhQ9S7V9KAKyeW1ra2tpaXKuqtcJVqs0wAmItqyw2xZ5rrotEh4+ijJTZdNTVhEMq3KA5fuw/N+uGxBXH" +
+	"tjSxQQrol7KX8F4qm0ftCfh5z2/6gtt27G/VPUWjZFQ7lf/uf/OJdM2CwlTh8VxWME/GS90EgikrBsb4qjwS6TuOHH1mhMk08BTm" +
+	"ERexySGpmP9dMRu1r2o+gfq15bAB8wjVopdKdcM43M72ubo+oKyVOTGg2vsUsH7elWUYF5u29PZEMovnOzDKzMkHyOElhg73nS2E" +
+	"5h/QIrbvio11bxNAphgrRFqL4UcEtI5oT1usueaEkH0pHFOIgXBPnFiSxFtRkUCWtCad4sV+0wclnc66YpC0xckqDfyReQXDETVS" +
+	"QK3FNDqYdakRChQZVNTxmDWJVaaTSlbbT5e9cQ/lrr0ldAcBLRXark/+FoH0ZdtvvAtPuaE0Oqh+60QxNdNUUeYbDh3b+DCgaEvi" +
+	"DCvGNLvoXzmV51584Sj40WUf/Zs5BhtRPnHRKAdD0AI911YoI8KyY5pg+STGDrfQ06htuSbRvWido0vDvklVulp5S071z7JK9b80" +
+	"qn0QSsSGDTE/dDq9c6o0EjWcV+j8bzCvpGWe4cb2GgryBBOltOt24uaNIHRGwg9tsw7zmT/F+6Q63mKM+wMnHRJ7MWAlhxuuDCJ3" +
+	"PtzgfRWLJlbatijaJlbZot3PaCeMSJDgUT1yyy9gtfbS0tPPtg2OCw1pIB4pSqpBmFj2CoUeNZHlzbtwUrUhcpd4Fzp/84+u7PvB" +
+	"f19Y6P4kxf9b0fVAJfcIGpxnSSl8e6klp7QzpmZxcMi2pKjgnNAnUJoVfGgE0o7KxAyKUq8iaiKci6YaqHPERClzg9nZUsZdOflL" +
+	"gpUgttuiotnXCD0Vtq1HIvrQ06GjsszfHqGFS27pJgJGCwjtcgBXUg/kSjauP99aLKuyqeDtFGAfuQIZXdrS2E4KLR03UMWjUhTH" +
+	"ojq5qQaq3uXYsOqrMU3nI8Jm2Bdfg+9y3Nn21uEsgDuFWOfjy7W/j0zebVPCMkYHJYQEM+y5J0VF3ZdrXRVNVMdUSw2jiSxgUaKF" +
+	"dhfYzATdr9aCWbfFQPZnZQwbeRR0VuSLU7mPMSV2pGjBDF0DLlCBsNd1Z5il17Uh43uczqDEqlGMKX1Jki2RPBUdKRcHTINno+i2" +
+	"8eXfuzAK7IA/r/b5vg+R3AJiWAv4kOO1OjqOsiuE6wtc2m5nCeYwZDUjRYstNtbtKgE2fTCRA4f4edD7XlKRB4Mqyuu+33flst20" +
+	"vsCchy9pryMmrKjfbSoK4LNDCt8XZd9DqjqK1WwtMZETEAmVOushB95cQCF7H1VHSc3HNNWzVel4Ks2rSq/QOpR83l5RxVCPS9A5" +
+	"44OJJL9zwYEfW1RbRxfbJvGVbKh9Bq6XV8Zx57z4oZTOabWUY297PVcIh1wCSprvcFbjGfB60xkRFMye9tW4FcNgHIFhXiI4pzDO" +
+	"mhhKxmnKoY4fJRjUcRVQNCq0baJyK6GtioqLQ0Qf2en4rK/KEyaBW9OabYxCK/QJtUXt7+qSFsrUYXx1NeXkEVlO+B4n4475VQky" +
+	"wzW233cpilF4PWeorMcWL1Sb3jXmxRJesxetjz6HpfHpJcq2VrWEMktJcqewplHlgeFUiVqZ83C9eqz1w+TvhJGn1DvCZ2JwyPSq" +
+	"ohJ/YUPlonCTK54crcJ31AGpgnyGPH5cSv06RXWJuqaqUXjLlybSup1SthAacbzvKXcqTVrTVGq86vXR0JYkfYy+m5xffD3ivDDY" +
+	"U9MEUnFfI4xmyJiE90GYSsB+5jbb2sUEIPwsgPMwRLlNOi8MlSsBBB6FLBn5Cug7gSmX1VbElzP8AGEQuEa4+hOB/47YP15AqkrY" +
+	"+W2ZPJnDLYHmMawlqlfDDZ/lTFBcvBt2jVwL2ocTY53rW+iLtWoUOM0neCngXJQzF3O1/L6SMRo6Y+msoX9T3K+NOdOe3cB1VFYm" +
+	"5t1qJyWixVj2PZirXYWYx2Qa5+Twi6LDqGwwyWFQFi0CAQ3uybBtegIQVyXZABxgQ21ITlvhByeV36GSClxrSKsZHoN7OvIxs3GI" +
+	"4U8CGG0U7ozUqMJ7qe3KqLOM8nTMPuvLpjKcOyIwD4WAkYONy1Vi3p3OGoydkrAg568wdYm4mgAJWa73wW0iapUKWIhTDnPKjzvV" +
+	"JLJAW5p4/tpeD5c42OGUeyKE8QnlgzPSEKexKtsLyoYpiVuM2hmlhE1ToVK5pDItVlThYXJmWESOoC1a66tV0V+O3HKkqgn5XoQL" +
+	"xUIl2NNSeZ0nrQmKeKC0rPmBrc2pUw2uECRXDbKOFXoft2XLLHvwuUKo43yW0CWuu4CRXyNrEPo62dKrY8IUUfT7ZIylkDCrD43r" +
+	"i2/CZ4HKv5teCG3DDs3zKeCs9r2q5Ew5AmQUfBNrZmRvIW+ZcC8CTGRUHaI2+6qEkc4vVpmnsO+G1FLw2Yp9w/Y2orVULbvUouKm" +
+	"iZkp3iEU+IFZqqkiTI4ReN7Q2VL2xq46nGLFE9dXcolsUOX0oa1iO3Q+Il4gnFI+C5+DOC4Wc2gGJ2wFmzwb0cwG126qjXZkBUfk" +
+	"a0RCa0TyYeFxhWSDLxNcgbLXmDcIrCDSkAh1rOwJpGko/czCI8aX3J9cHJVq+nA/5FJA9NE47kGlwuRnQQMESBnrhQZVTXsF1+3B" +
+	"We+EB4+0dsWORQSxrVFMU/YOPEN5EUTZX7hPKtYMmEPmtXmq7HsLnLcu+ZGFDUJCinNJ/BhYmwRkp5mDCYUt8Welzpcxxnx/Ve7F" +
+	"+yRJZeChE+FbKWil3O4WXI8UpMQ1WzsbdOIFt6SwYcEkB5xCN3HOE5OciPRHRVr0f52tmeaAgVmSfAqCwOcjypSwqwuruqkGlOD1" +
+	"PVhUMXuMZQcuJmFhvUne3isWfMHI8fs2snbAXi37Hv0FBdBv0d0phlCJWHLwoeeKguODK+gZRTQRq5RiX8Uzd14Y/XAdhWBDIFmK" +
+	"oOI8Uv4/xtxo3axzmlnkacEcaUcLBvVJkFRM8xCMOWMWUTfqnIqsD2UTDK9ZCEDD9eS3h4bKHKmfw2gDi5shxpisBISFD+kgR9n0" +
+	"iMiBKShrQzAagC5ivC0Woqd92DaQGBFnkvsWF38U8oY6GrQnv492qVQ8al5g0mNiZQbYgyE67Kh0MmZxBf7ElT4sTIs2AfSbxTw/" +
+	"l4Pz/Wl9VQNj13iNU1uVqxoI7UE7GGUe6bBT2/AA34eND9pjaW8X+m048rhSgiMoKSap7MwawzN8sAsyfYzlrLwuQrv7fbDozdPD" +
+	"DV87ycfT3mIkuzgf7SLMxptIkQz9g9uIH7g5VebcVS+s4IfGRYh/UwXnhrKfx8or2hu5eLuscM+BRUO2kew4Ljo4ZnV8KoHc5ldV" +
+	"/Id06mk9R11p44WXFveTIWz4lnOttC9R5WFPPJNiDMQqcCe4J4yIwLk3fY96c9PVcyqQndibi3G0cwZVittYzNfEbsE1y28mNWjw" +
+	"9lKjAp9XNc3xLR8ILMiAwHXbj/4vVzHxWRb3kEXUO2sa4fwGOzwQWiH6qvNS7gPjwpBrrIcW2wnjPKIwTjW6BlkScN+gGQFrgPZJ" +
+	"6kk6xJuC4wCN4CzErg+p7AG7ILFd+BqelWy/CO4lmXu2FVO9U3DoF4gEeVzLvox0ERS3j7AuKsBqeL3Tc6BPqy2ugWUsEYNcxnJW" +
+	"k/ICVW+fdmca2rvo7JdqU95nRhKntQjoYtsbUcEewdPxUEj9EFwZXIS3DaoaK6cq1m6WXEOzVZFt3CtsDf2T/KYXOIk9pgpvR5Dc" +
+	"Vae4f44t/R//LXzjx/8tfON/WVpzR3/UrNfVlnE/WWabBM/yZquKbLIRUWLLPlSZSzFNLG9BBmAb53DtbMElhOGQKgCK1enR/qG9" +
+	"SPEoBRfzOP/lqFM5nb23kF/vDjLtvbt/du8RshPu7p+b+fPDxz9NjFxfffjeX37/iz/tfvjVLy+RKjT/fO9c/Pmrt177y//5LyC+" +
+	"/Obunz777Kub//rVLx79x2dv/eXXj/5y6Wf/cfeT/7j7yZ+3H/7p89/8aff+n+699tX/uPynB2/9+c79P99/+6tfPPrzh7/86s6V" +
+	"//faT3ndYRp83dU1wQxEWwVABL2K43V4DWyRGAfrozZuJaABzsfhNcKEafs20P3/8C6JMsvnVSBlOowuV01tV+1LFkWWCQWOnzvA" +
+	"0QvuYehqeOZ3/WqN9jCqDpdVICYG5t0cgfFK16dCcebyDF5S/QF5MHveqne0gsyA+5BgEDSjX1GcVt4L+Fbo51Hj489wM8mduXKA" +
+	"8RmCK0i8rtIoEZF+xu+mCL6D8kGRH7XI3AecgcDQ9w4yG54D5r/ECym8m/s/IzZJYP9jLsbE2wnskmeR9RFZGYl9cu8m8gDuRLZC" +
+	"YeMERsb4rP03YQ7j7Lyt77O3k7gGiY1Sno2/P9h7gFyVn+DM/xR+i8yFwFYY77n/Bt7xFvJU3lPPUtyIyK+4u38pPpPffW8n3n8H" +
+	"2RGF23J3/7wwa+7/bO/fkXWTWA4jT+b+BdWHF4DnEjkj7++/ie8CjKavAWsoM3beUVyhd7iHbwJDJ7CLcp8AB2Vit9zZv0j9s/9q" +
+	"7Btgb7wN/br/xt59Zl68iSymb+zd27u9d18YQPfu4F0e7T3af0NxSO6od7+J/UTMnffpXYl7EllM73PrPiEeVhwxumYX3+kW86jC" +
+	"DIA3Qx5P5IMktswH++f3PsMW7PJ9eByBuRKZJT/bu7l/EX+/hC2A+RnbtvfbNG+JBxPn1ud7t/fP86y5p74L7/II+Tcf7n3Go/gp" +
+	"cMriDMQ33/sc2w8zFfqH2ovjAmMK7d5/De9AXKHw10fMQ/v5/oV0TfYuD9VY7NLzcM49THMYn0wzYFfWF/Jznt97V62dz/cecr/v" +
+	"RhbVOziPHsD74dum9bIDfb1/ltZXmud7O4kjdP8NnKHwHg/23yQeVV5TxHBL4/sA1wDM25/vv7n3ANfN2f03eQxpT4jtx/V4k1fg" +
+	"vb27PAK7yCC6g8+9u/+mmsMwjmkdRT5UmnXY37D//E/mst3d21Ha3OdRhfmmaElfZP1x0kDffiR6zVdEQpo0sq9/cfUy/umK/PBQ" +
+	"1LEvfbH9bpIgT/chIen31T1viHw5ykzD5+fxkw/5u9vbqj1nUaL9Ybr+6iX55ApfnNS66bn3UN37utz/ilzzgeihR4lqFBDn6+kt" +
+	"3mIFc7onS7e/nZ6VnksS3q/hD1fw+o+VKPYuPo5aS+91F/99Bd/riqhyPxJp8k+xYSQEL82Gz3+NnfN2/o5n03ttP0yfp166IgLo" +
+	"10Tq/aLSlyfdeXrZj/C5/ybfov5/T/okvu+7X2x/js+N73hernkbX+RTVI2/LCLdcazfkhG8Ln0r97z6qvSP9Mn2QxnZ2zxnSOWc" +
+	"NcrPy7vfkDE6yxrx3FcyjvD5B3j/d/C2sc+pJTexnXdlxM/jh/GaN0S6/XY2H7jf7mHXbfMPvC7iHDgrb03C7u/Ls97CNz0PevHc" +
+	"/l3Rgt9Nrxzn7fbD1P9w/Vuqr3ZkBe3iba9Lf15ngfvUZuqrX4uE+u30XtyrKPHPyu/UD7/BO29jm29iT56Hf7dvp7HjdfRQpORf" +
+	"UfvGjnx+Pc1h6rer53j1bd/kC65ekmt2ZZ3ew+aR9j3NN5r/b0ufbMugfCpzgNr8KTf46ln13bOqDy/Jen8V7/ap9Mn7an84q95R" +
+	"7Tn8RtKl2zdUe26iWn3cZ/C521dk37gt+8OuzI0r0ku3Wciex+uXao5dlDlzVSnyn1fi+Hr/PIvforX2Eb77I7kPzf/zLLWf7fn3" +
+	"5Jr31ZyhiX1b7QO/lz75QHbybd6jtrdlA6HpsaPuf1NW0OepH+BPr8ifbsiIX5dRwJZQt6T1/stsr0iDK2uN5/kOv2zcH/hXGgua" +
+	"ez/D++O04V36Zjrj+H1jP1/EX3fxmg+kkW/yO/I1uzJAt9U7XpJ/f4l32MHP76qz8iZ/F/YNOu+uxnFHtmjgxX4dGZI/QT7pC5H1" +
+	"+c7BXWFsfnwO2KUfX8TPP4XrFVfz74it+eA2snlHHvDIsHxNcSx//PhVYGY+uPX4lcQMDp9mHOI/JWZseN7j8/ws8YDh5zvIe02f" +
+	"fwBtYrbpm8w6Dj9/CNfyPT98fB7vRO25hbzXcs9rj88/vgiM1I9fPdjl64Gh+5XHrzO/9SXhKEcW6fciN/mtg98+foW5xq+r576K" +
+	"/NzYNmY4J0bqO8jajc9Fbu6L8P7Yp8KMfQv5zV8hvnHirkYe8TvIP02fX0AW8xvcb6/gW14nxuqDG48v0X3w00+RL/31g2vMYn4D" +
+	"+iB+Fzm6ma/7+sEtaSeyeV/j/rmF977L7/jRwc7j1w/u4B1vRb7u36t/d+C70ueJ6/zgtuqTj4APncf9A2bXTtzxl+TzOKbA733v" +
+	"4HdpLOQdD96H1lA/HHwkjOOPz2GfJHZvGReYebd4/nx8cOvxq3hnmP+fHdxCnvQdbIHMjeuPX4nveO9gFz7HuGJjPWYOk5yoL9fM" +
+	"ioKZETc4Y0+RUmNtVHP0skx/QbppCSsj+XBty341nDuKyRDiaxkDiqdxfUMiWj1f90ZDjjguamywxuSHzgQSM+W2FZmV60NeJ0jO" +
+	"NvEpCZ11O4q8C5cmVqBv+l5VLvpe1RYi0ZpS7UKf2Kw78z0bgu2tj4JrGiq24MzvCib/JDODQXYJw27UVTkqOaGhMBBfGwgIHSsF" +
+	"nlGoVzOkLJQtXTUKiHOPwGARGGfCHxVMsXVvvU0gsjaGnklUaaVtA2EXqWZwtFG7ISA8RnWjocUm6ZoW4/lnMeDNMNmNM8up4Ynq" +
+	"z7vwtJ47i031XcjKnrTBzSUEEjILJHgd0yRz0P8ZBSKZ50g2FU22jaL7ZozywBbBAU94QBaS0oXwPdf3jatrWzoL2cTaEi1GrXJx" +
+	"JHQXk4+GFPu4q2BcI5kak9iueW7oRu3WCXWS6raR3kNhgGIOG1MRzAe7COkMzBpLKugFW5pnalv2fOhVGm+SCGWwzBFuhDWdjesn" +
+	"ZnhbGCGjwRXG4AaKtEe2XoZSMGw4Ul0TDhtFq6Ow7JyGWZ9WUBjjS4IQ9mygYocQiYcjF52pBt+unW2Qldf60uikKeRTNjFHbZpK" +
+	"0e6vtJeNKlx4aRRS0QkXlzAR2whTAJTWY+ge/KnCIvoEEiCcMW0BqkfNjzAFJOnIlg5wG40aFp4BR9wVUfy+MKxQQKL2NmGtiFmA" +
+	"+aiZq4pTGqZX1ZR2gE7o1TgvaZITLoExIoq/vLNE0gqydeoqDWb7ERgkFmmtFlXvZWRMqlmd1cTKd3iOKr0uxkmW2dZjzL0QF0id" +
+	"gYWWFXK0GCvau2JM6LUfaygbtxtHW2ABHY23qHRxQ+LzJ91mpD1ZaVdrx5fOLIT1qvfylt10C6keBdcpcdRjNuVZhSCfZxQE4UkU" +
+	"vsglOjtGZbLEIk57lXdJGEdI9mrEiGzVIhRbwyaB7EMa4zafEpo28GqkxWgiTR4Reoi2ecGEHbZHKINEyQzwHuKF55x3qTgqYyEF" +
+	"DDCmOuEq4FVdr4YQVycZcFbQJxBvnFGwJWn0Z6DtTJYMc+0gUDBWSDrMDiXN86Chel8rhPiHEpLE0InXWaMwwm2T+Cy6WSI1AoeY" +
+	"f0gGz/UpRcYwpFNlv1oQQMnmqCh5eEkbHBGLQg4d8euI+RawWFQDPembMU9Igg8IpyfhU6pRHeV+0w7rbckEKyTqbhv1i345k4rB" +
+	"erZY1GUmxMHOt444LRh6VfdyuHVKCDHgSoI8GpwvTuNAF1nYmCSAef146URmyITZyXhvzEpHogDYowRJShC+yKIKFyvJi4hEDjRD" +
+	"eGVxGeR6JdutwpMWY9jjNzaEVZgJw/F0wf0tYvKrkiTw4bJOhj6m7uUqeGwb4y1Or7vghESFaK1hA8AsJEs44y1p8RKRgOic4qSI" +
+	"RVVwN6yIaHFJRIT8wPad2DGJ1Io5L70LSck3UuzzFLOlSfLMqZTMVAPiyGaqbEjNxjw21QbxDWKxDGzMhNQUCGcqEWgbWWbEbRaB" +
+	"NYOqPqF3/wTaM9XgkC6cVSx/xVhXkgY5J3EPQTwy17n2jdLbMUlHhjkvI6mPTbVvSKmDcBrs0bjRcVexeZHXEhRjJqqDk2GlrSoF" +
+	"xsaGcdkzK1SLFvkbYO5EMkmYYqRfzDx+RuPhsXTZWzoOk5CxomwzYB8kqX/jS+GOwrmjCoaKcYf3VPyfkGXT1lmaNBUQYIVC/aYa" +
+	"KAJBW6SC1mJsNn3Nej62KHSB7rw2j4PwiI+pVoHnAbOXwXAQADJpehofnuJqRSb8TLVcATgCIlklGICCrj1+UsHx5k/l7gufp9gr" +
+	"MJwiMcN0RnwGw0zzA4/n4WrVNJXQX83pElCFRmsbRe5ZjAlYIcxVkc6ZoShSMh6EfhQBcFRelaiHsGAIZ48u2wzJEsIb8oQbNStt" +
+	"OCROwyokZoYiYeIUNhCJx5G+DN3UgS+GRhi2F6gKkQp8RTUBJ4UUUtJOkYwSH1j0nm4qexWChRiwRkCyUblRu56DHdb1fTCJsS2J" +
+	"ihhfMlbMIArci9AyH+8bo4aHUViZcEUmIV7TVId0KaKtXcKKwX5deC5a/Y4C2psTejlHkn24LOnfI3FGVOQyFiuLXCz5knmN+Fvh" +
+	"nVm3TSQRIPJhZpAytuxntmesWRW5fGTlGDtbfzuxZ1WDRBSN2KtNF8FX0vHwlQCT0FMpZFMd0nVqmz6M7Kxi+3mCsDWI2gu4MKQk" +
+	"AvaQeNqiVo60ACt9iH7Uhh5u+opdxg6R/LQadHXltnKgqkE8R7xtIn8eFrRpogGDyshSei/nXDUwNmhWe9fShwRZ7vxLLBvgeuUE" +
+	"3Yrk8wbDQlzJQJzmMt2MDa1sVkVWUWRrIJQr/g+5EYULKllCgwq5e0K1wNNZl8JiORDO9OCIiXaDxQdisS2cP2rC+vCUE0149uuJ" +
+	"RsiXa4Tq45iO3qpoDxdscUsPiS1w6yIyWlgysbQykWGDGSGxgDGTScYKH83gEOjRxgdUOGNqX+SF6dlSjjZfqsKhpS72TixX1uQN" +
+	"ip490KkgdF/VQO1iOno25GK9poYh98EkdnouYaLhh/050qYXfpOqpHj2igJIMX6mUljh72vbXxHb+dIHdaARjpMXu9RSMyBQIN1M" +
+	"DC9mUTdRW3kXku488ygBvhbaVZM/h75bEwj+RyRDY3F90ZP2wSQjhflAiVNGl7JYFq76Z1zBS7rC1ur64topbRLR70Dcce3gZpaq" +
+	"7Vlpy/ZHtnGnI4S42arWarvpZfNmcCX5m75sJDJRjBP9FGz4NJzrGBbhAmWqeFSVzMU4QSkZLx0B2bYoPLYMZj0x0SJbY1NpV2Se" +
+	"cJZxsIxgNqsBUz/RpNBWDbovMfpVVLZ/ct3WzaqzTVOZFGcmCi/qKV3DYgsiuuRKFCJc8i5gaUAqubLFqlMeZbRSYbww3iuqIgLB" +
+	"R+6WZ1w/HRLw2hGQGp0uGBZrVAE/9NnzUqCDISD2UfqD2jkTAJFpa8c2n2AupcaGQfQRLGq37DjC1pdVTUZH1z2MlZqVsYyg3XL1" +
+	"YFRockj7onB3wEYQt0Go6BZyZmx1/AtYdojkFn9oVDa+EIcXd9iyrEawzcNMjLw1+rVDl0M/GEcV5jsyzp56zjz73Glz4runn/4+" +
+	"k8ST5Yn1KmHp70JVNyt0hDJUNUFtB1W9rgMDArwdofJMYoIuxikiA3tf5AyognIRfLnmA74BceEx9SBNf80/U6KxIIZFgn5TKa5U" +
+	"GQe3rKsX045sy/6yUqY4Tp4r74mJ34WI/1KGgM8XOsupZ/k7q3bdBmu+5wpAhVZ1eNmsVi8P/3C1oF/KMf6fOLz+n3eRxYvTED1S" +
+	"irEFmlWMAqU8CP9COxX+wpKMSfHMDlf92ghXx1MVnJmgDXtk3hyxfdKm5+CaH46KhnIUxTgqtjOHhPInJKFCPsJxxmazH6MZQVqd" +
+	"pa42IiKJlaX6rUFtY39HJx//xuKj/ISODs51V8A3/Pq3Tnz9m898/ZvPdLLUiPx/owpN2yxlaaCfHNXLcjEoaqC5E1lPrJjSbZkT" +
+	"dW3HUGSQxXqyE2f5xXKAyROM0M63u5qTInt6d+UIzoyw7lxzxLD6FNvI80YH7zuFV6wkKOwZlQsVpSjs1U27m96I0h8kINQ2rSyA" +
+	"HmVbLcu4DQ1VtgERp0hSRFnHVCu8iI1e5Jc2WbCC6CSEl19xzSMvaSQu6Y8Xszi2OalTeIFGShojNZIUBVpY0H3/fO2HzpAShqvT" +
+	"wRAa3wPjyUQ6HxKJiaJeWXwC/paMGOrkWElrU+UyMSomO0a84x73Upqtm1jNlo6sbAW0KMYW2DrN9nXjU7UPhoZ1aoU3SdigpBYx" +
+	"rj/N8GCLoNMRC0oL7qj50ZNJU6JZd5mr2+pkKQnF1At1D6ziQN/r0BTjzAyWmckt8b9kM/gUSzJNFY4um5fCou+bFeP7SV3hiSe+" +
+	"3s62U0P1UCdts14VvkfRcwlODrQxarIMy7FGh0NM30NDYzQb5khUcdnA2TPk2bP4UlBLRwm9wmvR9saPoAL9p2nfmttaVPpjc2Sy" +
+	"N334p+7GuBVMJXtK53LnTRY1OfnPrrceteY4df5ffLnWr4Yp6+TKHqxFMknQsSZOPKkdn8tCYL1q6JJCluLHi8kRHuiQUnOY9ML3" +
+	"OkI9cYT5hwrfbXUKj+wk0V/tZrdh3UR2aOayFPmhbAvrZhmlVF0JbVWKfcYGo8uTu0TsLLEhjs3x3tOz9SpSVFVnfN+1Mi+Y/0eZ" +
+	"goWlLGCsdO1ICFLtRJ0sISuOIqmnnPZffvaoXDP/1X95/1JD4Z/ARjIvPJSoPP61J7LUQpbG6aDByOxEri+5fbA+2iY7nFqZs2tc" +
+	"r1e44C1RA5M1K5GqxHYB/dnJ/Bm4BlmbokFGJnjjh86Xhg9uZ5oqizYY9u+pVz3FlGV341g6j0o7c1yz8JthCTAe6ZD+EMmqF2AT" +
+	"D67BiWgkt8AOBF0owqKM3lBcO7IrbtSOQxY43WLlNUu2VrUpqyY5ZjBRU6UnWNTWILMJgwbwj0bS5MnMhFkXOe8Rb5AyNtBQRXTH" +
+	"PlkSfD+hU09G6fz4JiU30P/rZEezycwZ0pOT9qDvEOkOcMfkcm9Phm9MaqcdJeCV7AdQnRytN1bMh94w0THDcAd8gvE1Z+vCu9DQ" +
+	"IlfR6i0bMjvkcLaKjVKaxRCK7NVw08PZumW1Zz5NsmQzcafVwqOPGm8xMaFCErbsW6O58JToR4w9rPJdZIXTwtVeWu1W45uCaSY2" +
+	"Fe1gYCX7uL0mqYWqdIZjCzw/T0JP+yKG3bxaAcZoyzjD3ZjMlzTLGX5JMTXgClCgp5DYoRA9UPZNErK2ibvdeMVVBleabGdX0n2T" +
+	"Z4c32YTJ7HJjMb4g4BLYewJKUcIclDQwsT3VDs9h3kewB2OO/L/6nnLLSd1R+m3DYuk186jg96Inq0jxjm2cUTwaHC1kPm8IDxDF" +
+	"BK/bQ/kL6jipgYU5XC040sNBPnIHg4gnUI1t5ujFADEF7RTPJ7OAqHpa5maqBsqr5N86WTg3a2YzlyHxCLaC5baubFSEDeegsHrD" +
+	"qkKOjRjE0asvOEn9Ub8cU2yCnOeJfU3vLmED+k3cfqU7yD2f8rKtLKWpZAlZwAMzdph6SXW0sJdxCCbmrSNhvlmV3ZNbLUu6cLY+" +
+	"vqoUqYBvKekyGV8SA6Z3W7gCfgjmaQK62A3fWFm3h1sKbxSYmZL/xmiOEaVJz3zvu99pmo3vO6QowTxkhKkF2NH6ot2OllXszyxh" +
+	"EsKoHtiePAEtucilNqhQ9h9jd73xD4NrkkcfU9FCOa8MvVOZXS5eVtQtUMp9pCYk7OQYSeYudv2nkuK8rFsnod2TmX9EpygcZjBq" +
+	"uJSi1Fs2k3uKXcdUg8xpPewHJHKNwleD2q2x7oDQ5PuYho+4OBxNtqif5f6kOcG0alXKZ0E8bsOpcT+RmCpMNWhl25uc9lUZ+yWe" +
+	"K3PZ8s8AeyGXqyVhaxG7wFbEHXqpqyeaySCSuI5GNYA+eVUl3pxltjwHg8FPWoutfKNIgjIYvYzas5CiyiY2Jl7ivoQWRAzYsaco" +
+	"HlHSFTfVIEsVYIo7YXezpqwq7UbjWbGD+E+GrqwkeUiCN9qFziJqC6w+lvYCyTNwBo4JxvBsFNyWQUatKAhufBlJ5PCZKhvbrDuy" +
+	"1QXDCbtGsuT46IEFDwGGdWfUfTG7Liw1GmrQWcrwEkYZS63DrSxGTmpeUR4nwjtxV1TtHFR1CrnzjHQhiFjqFosrkMHPyiS896R7" +
+	"Dm3ftTO8hVKuNTbwniURe5Y+XGmXVduQ0JtIL586NW++UxVjcuShLUkKWMKwvgb5ppQ8hc8ytCKBWaO/aTHIIAiNpjJKLTaSuAlz" +
+	"F0t3C7dQSnJpNr2qlJS3D0mPiXNgNuowIBo40sJUFQvQpN4nv428AOXj4f+ysxE2D2XlJS+KBNOiULlZHSeabLLPMGnHWVX01aLA" +
+	"o+BTlUJNPK8wgsX8psVYTuYQ/b/EBqqCikoun1g7msoowjSGJvNaEdFUXmZGn+gUjRHch/Jz1n1gCz4K0DsT+cnHCc0e36gqWeIp" +
+	"20M2yFcWJdBDhyafnuTN1bFlVscZLFAStGyfcdaCF0UWPO/JLkAJuQyfP584snzZVJn/3skw5CbUG+Hlah248cLLvsliCXvvQO0/" +
+	"sWns3ds/q3+L1r3iWfAb9BtWp2M9OtZ5P9y7pSrw9d/uYR35fa6R/0xXpe+/sfdv+PnD6e/tX9T33P/Z3m3kGCAWBHXl3k2oAidm" +
+	"AeAb2H+TK7wvxSpv/YT39j6D70AVPLIDROaF/TfUPYGN4FzGBaBq4Pd/jqwjwOcA94R73OIac+BGOBfv8QDr0C/FXkE2if1L/AY7" +
+	"e/8D7g416vAOe7vxm8BRoXoJWQN2sBr9AXJKvJu9H1TqX468DOeQC+KT/QvwtMRyAFdyZf9NroyHKvsH2J4Hew/1E/Z2sp7Ywf/f" +
+	"wdp3/l782+d77+DV95HV4CZXo71ycOPg48evxZ9elwohrOL6FCu5uJ4qVbfh367FOrZbWHf0/uOfUkWTVG5xjdi9g08Prh28m1Vx" +
+	"UT3VjYMdVZ10TV+Jrbl28D497+A9qqbiuieo8fvg8etcTfXe4/NYM0V3+fTgM6ju4nc4BzVgj1/nerobWI32+sH1rJJN2gIVbNKW" +
+	"9w4+gqu4vusaVqzJE97LfruB7/v6wUf02+PzqVZLasD4eR9BKw7u0j2xJ288vsg9CG28ANVc+BvVo3148LuDewfXuYbtp48vxXe4" +
+	"Hp9w6/Grqp7tvYOP1d+u6Xd4fBEq5+LbXjv4jOoBQRStqo8ToKZejkKYdBKJ0gsf+O08qqskhZEOlORXpAgGtjvY0DhXCjmXHyfB" +
+	"vM7f9KseHI0Gg4/M7Bl6tXNwSuYB4ywzt9Rt5Vt5ntwJKRC25iCtl4V0Wwgi6Y0aAzdsm5h6Aj/fzUn8MCYrdeImSGSHW8XRDcp7" +
+	"t42y9uLh0gObCl2UVn74ya34VxVGQOc5C22jL6r8taPLmauamf7t7tE8C7ea5XTS+/aq6mU/EUFc+gn01Y/Idj7+xLLWEAE6UNYx" +
+	"51st5skOOGaVd5ShvlotnTzedKaTj4pKv8Gd2xm2oJ3qNvCvSUsQf+Ukqswr8thiWLLdzaKGufXVOVWSMwX06v0wL9laQ+laFvCW" +
+	"B2nFBxCMyK01G8yWK4okW2A0i/vhPJjBvcJi2C2l2i4mDUd/oxJ641dZMZaNvVi1kjk8x/NUVcfkqXhJBrD18m3UvI+UvHmI82uc" +
+	"eQ2Ned7WzTgRzVEzUtqiB+9Nz5cgEE7kpOXdNtlaSXAzmvHg1Cab8rkaVm91xpxcH9W9dSVcDBfnfi/iu9TiISDLOkLNbLP+k6Nz" +
+	"2tKisZbXDRHHSrfmaRYUQPNZ+LdGmF5cmoRfVlCPUV3MYYw5uaRhwvuRtByZw1l1ZRm9WhWwSmkKfK7yexQlIFyfPBcKhJksqYJx" +
+	"o9R/Ul3Fnk8C9CJiMt/NultR0xqnClKh/rBnaVteURreyugXb1a4f1kSmdX65fVxcBKRu4omEaelWmVEayayL6Yq8zybyIOPkjtg" +
+	"hCUXQ046DGm0b2fLvkZQYRymKOxG3CixJjJFX6N2Jf2VRGcYMbs6VjryNIKwnOlBiHZeI3V62hqwVak0IK5jAjHYvO70O/nGSCTw" +
+	"0S9UYTJxZVWc6cWSpBb7Bgevm29BGuQII9hUG8/X1YZds8KWzBrMqgZFoq+q7AMmCNHKVUO7VrrG91wJ8Z8XIF7acyHlnelBpzAv" +
+	"FUclbVW4FarC2E1bsx+7KayTOZ6FuUBjvD+rofZlng4jvs4Ep9PFLoLGTlnwbBM93BoVXS1lFecqbTKYE2G8JKwymqFsSRz3wWiQ" +
+	"sRwxVNO5bHIYDiwNpblDzIdmjjgRj0aVQRIzTEcqiR9q8JcLKY8HPq5Duo8bSNNBBE2RBeiyMHJ8pPimbvNlV99gZpir54heQ7GL" +
+	"XBGOlF2hARFel6vnhLloF0g8iMaEGX5+Ixwpj5AM5EOmzeG/fsosTMz6olsVWWV+JcRKkVREuLaunkvXM4fS7S+uvoZf+UhYaB4J" +
+	"scw9xS1z44urF4BjBL7yDt75t0h7sq1Yp94SqpwrfGe+1dvCmUNMLL9Ut/09MsP8TNhUrkszIj8MMSC9L3/9ICMdYuYTYf6JRGFx" +
+	"ULgnt5nWiSm5zuGfPhDKl4/l6+eF4+V9xYEjlFDwoP8praLnEsfLXWn8W/z07deE12gb/0S0V+fxia/inYkx7A0hdLohJFrv4c+/" +
+	"FT4iTf9CbEW/FwaYy9gzdOdfynMv4jy8jO18CP9eRTovZhnaZQod+Hlb+vBNYaaiN/pQ5s+voTFXLwsb2Pt4q10ZxPd5YvMr3JP3" +
+	"lZ7kyXYZvxKZr64J2dEjoVHaka7blvd9H6mE3pbvXpf7fCpjRHMj8si9L5N2WyiJ3hIWoBuK5eyuTGZ6xFlZQZF+KrLobMtqOie7" +
+	"wSU1Cg+5Scwv9C5TD109J+3clun3liy9K7hwbiQ+uqtvyA3fxn6O+8n5tEVwZ1KTfsMfRl4vvjNx+9yWi2XGck9G0jDpOrjsCn8F" +
+	"/kSUTcLldfVVaeFNIe9irrM6hG9ojY2FJs/y4Mfqd9tEkDCm1XODu9WZgGGYrK6s8N0Jr7jhmtHFoS/xVou9wvdeTpktk58nCxMm" +
+	"bUvuH/1qm7vsR5cnckOAXu67HtfIHzcTtmrCJ6E5dFz42DkUa54kXDKUa5cueCu6jmJVd+ABqJEGFAFuhRB4yVuY8PxaAMfQemOR" +
+	"1oXbb/t9hM99F30PV0f8MBXEmjAxAEsTsQLmQC7XTBiHxg3buQl5rEHi8JX2DyFz/3K7i5w0JiEvFdq7cINmuTURMZg3eTbfoomd" +
+	"NLsmnPVOfD5nGJcnOoBwCslURayOLs80OeJJOT9Ul6l0uMAKEldTfM3o4yL/Q7vbnvCoWsZkaVW48bH/VRV2cL7pKTewo6KZo4Ku" +
+	"SKFinhz/M6mCM189EAf9uFkfDVd/DP33400bGu0aqh/xJcyEH5JGAnEeQfOCwxdeoKxTlLTResNUg0PLh+3E44zUiAZkVjIC98Re" +
+	"KCuyFdtdclUSVlKXOOJ4mxwPms+uJ57g9bEQNpwFwQ8q0nS1xIeUH42kI3BPra0HWqw9mME9csPbucO/8AwW0GKG2YaqnJ9YDofC" +
+	"k+PTdg0y/nPh6D8+8d8nTNvO4sQHaSZQgOwINuvIEfYbjqj8GHYgB4ViEDDh++jfpQ30PYzp0A8TPkjD4Yw4f1cn9pfIMU6i1kf/" +
+	"eOWP9/94/493//jgj/f++NkfP9i/CGzAezc5fo65BmTQvYnR8V1kOQauY4yw77+59wvMVEAkH/l+83wHc/7epb/it3+NDMHAJwzx" +
+	"9/N7/45ZhB38K2UsiFEZOZ/x//n1OgLPfMrMgbu7/8r+G5wd2OHsxA5nBR5iC97cv8g8vMQaDTmYR8g3fIHYbPd/Ju+3/3N+vweY" +
+	"L0Gm7L2dvZ28P/DqB9hW6p+diezIzRTdV5x4zEum4tqvQKT74NpkxP/xBRW/vqUyCReAqe3gOn//zsE94Myj+x18CCxl+ITETUbf" +
+	"ej1y9b16cEP9HZ+PfHs3JI+Q8ZxdPbhDEfrHr0+0MXHe8fPqarVqtFhLzOVTLrfdTTWUTFjWmTyjcI9DkBvvdJOLqhOJoQAtU/Wq" +
+	"Arl+1NoPbTMRUJxcSB2tFILF3xQxQNAL/UggeMTqlBxZ40iueKPtie0tYedk/TZbrmy8Sxs+qs5XJS5hXMyi7mmeBknJ2tV2MWNu" +
+	"ONzKIi1Qq9zJM6Nw6kjMmbswM1sAEh+/wgPTFnmc+H8dRKKtR8ZFUL05V9K6M61J64P30gQHpCGL1sxK+0TtbTFvvuOKTax3ncdx" +
+	"0VmQFMLgL6cidvT8XRDMf2cJoTHdTmahgerTBDa5GlB0M9YATSUqjnQYDb3SHjWDhf/c7rYSSjMCEjedLVRYsnEFj6YJDqPjk6ed" +
+	"mTSHEOgjcT24R1ZOBU0HBMeAJJfoGMgrbxCQoIrGVd0q4u4G/ky7OzG0h1vNeu3cQt8PSQPHFhQLhjeDAxWQjANDcdSTtq4KX9oA" +
+	"qvLOvOyLauiaGqgEJ0xHwhr1mjhjIh+SBOGeX68whGVOsGKuKHjE1+ecQBHf5UlXvmSHntjdXi58WVeFWwATC3ptzQ4d7x+pkseX" +
+	"5gckAMUKWqKhZMQ4eL6uEIT17VHjylWoGNZEOAKYRVQOsiMh4kVRgREsCtEpWk2Vmd0kNyIuR4qPS0xNIJgCDknWfdz6MKYYlqQ0" +
+	"LfYHy0qBjYXRx5CXQgEyiCzUFMZECokRlOVBqYTvhYz5C24qYG8Sb7JrFa/jw7KQD0+aBS3Ajxkt9GzL/rzRO0JGCwVP+Z7v1RUU" +
+	"8pofYCYzpBoI3gsTDI3ngTJmaGvL+MOwPypc8yiShMHzxomxxESVQkyhoEy5C7FYO9tDqJiIIflQFbEqBi7Mir8loPlc0U9kJ1zl" +
+	"mrTPfRkSHsUgewDV2sSbaglsrIceIibPN7GsQAvxspLO2kgUNgm0GItWlnoBsnFJH5odmhOTm3T2BTgVexUU5vddnNrNVrXQrPs6" +
+	"CjM+NWI5PB9IlbAvH8Dtal/180IGQx2UO1bMj8d5u2KckRLy2k/Mk3DswinnarV/ZPx18BWaPRQvx67OODchbxEdE7saqmLUuOX2" +
+	"5CYcg9zHJR6+rLxScpSWX7Jnlgq/GjiesHRsMaWopNqIBkPFuZd4WWvzB00Q2OUXkIhkpf187Tdtb2yeRxQxHH9urv31b51UZX5H" +
+	"ptrcfU7pvqGI5rzyBMlW+mYngSflLFWkG4Oiss3x0peuQTyaLMKptd+aMsjCdAdp64m4c8zUzEvoTFF4bJtJQ6095VEqXjN+PDTr" +
+	"Py0VVRUcV0/21QSlCdlZmrQKkM2uGCsyDtFJTHVYy1NGoE6UE94tIzfE66uydrYPElmNo2q0PBUE53C0L6KparKECvm6SDlGEqro" +
+	"sXf+5qnnTp7+359/2shQaNIe+rfdnYxJZQOMUZUc7wcPW5kyNHzJJ1NtoPRjVLuJzcUsdfOqJxjX79h609Z9HdFJRhW1aolMZV+m" +
+	"YMBzZwZV9iXDVvnLboxYgbbp8VuomaY8Ba7PHCDTRKFqiPUMJ/PcTJnteU0GfNSZOmgWp07vvJYCvhUKmCeFSq77ko/AyPrRuMLl" +
+	"lY3CHJXqTuRQI+mwQcNEhcJIZbQWaDIvdIUbFdBlSxQMxl6OFqUaQX0VjLs1kzGm01NtnvJrmoQvhkCUq6uaSXzcmV4xCkggkRVf" +
+	"YvRFq7ORt9cbFVQiHLlvTp46weGmZ2yvWa2qlyM2IDFllDRzbMzdSt112pSx7NUNVfU+Mm01BcTPeqQYS8nvYmwkfDQvY8Hawgqr" +
+	"TrtAYMT/c02jVCO7U1vkidJMpNoV2Umk+wQHZlQnk+BkVdKUbhw7wzBb1lxZjQKX4oXn66rnHMznlBDXqOrIH+RdUAADAgkMU3WO" +
+	"zoQadAhs/yWLmJo4xzDulQWE+z4EJiCKrKeKE5aYbnyfNtqYmF8nYlJhKMd6RkZKxHDrUxB5RBtKarEjLbChClWhNdKVSlJFmhiJ" +
+	"yYjUvAm1C420L4FP+O5UgNVD2hCawGXja6T4DcFFnfDMKYjLKkYbwbqB7qwI8+N7CQZj7F87B5OnlJm1W7buhwkuhK2tRcY7KYTK" +
+	"kxXsvEAIw+x5YaKGrO6tK0ggz8yJWngwzKmGEsa3asqqcUHUNoWCqecy3nf0E/LqXyLunvQz47M90G8tbqxvTGDtjC+nNtpWYscU" +
+	"6iNrQlNXuEWwr5tHaUHWMceYw7eECCrZ8ad1hQO+u8Dr2vTw9tHlROPjiV/WZVA77GI8VI2miOMyLQzJELfaE8tmIhmV85PgPMQT" +
+	"Fn29pTMLEBFWD+FxNFPuvP4rRdFi+iH+QHsmtmfDI6fL3r/uv753H7DL+2c5lntr6pNtUgFDJPkrEN9lZbfPJlTYRNWOY5z5J/tv" +
+	"clT1Diu8QVwV4rqP8HeODO/dwm8+IlQ54qk/x58oyntz7z5HY+8iqpxjzPCJ4Lr56RRRvo+6fqRrRk8H3Pdn/BYT7YGYMtyTldsg" +
+	"zvtzRJ4Dzv6TvV2O9YKy3S2OJj9A9PcD1Gjb2bsf3xSuIRy+fPIZvQWrtMHT/2XvPqP94U6f7JNi1GUBDNyUhOyrmFE9h7np25jt" +
+	"pfxyBDAo3TGWcLooX3lf0rX3JL1+WbLVlNJ9V2ldva+QAJTv1gpcgo64ejZ9C/LOv8Gf32YUB2sJEeLiNyr5e16l3a9jG84p9Z+H" +
+	"6r0iWia+xa7c51/xK7dF++y6gENuCq7gLN7/JufNGUtAuBqSxflQICKfy2u+il/R36KeUf3MfXhDvqJUeBLG4LLk0zW44pFCbtyQ" +
+	"Tn5NBuKewueoPkwAkogl0FCQhzL0gkZIaJy3EoQg07w7p1AonyrkzHvSY49kdO4KKEKBizKkx2XRwLqBPzyCmzCC4gPV2vOipkfo" +
+	"kd/k0JTbcvE1/OL59KbpWTdkRK7gtz5igAqL8dEEuyZ98pEocFHXbYvMlmi68dM/ymYv69m9ij15FoErBGN4Q0BBJHJH7fml6v9X" +
+	"pHmXZD7Hfv6AcSkJVvS5zN7rsnyuiwRVnGOCukmf0DBdV/28k+5D4BBeF9dlxUXhrevYwo+VGBwqBsKvH8X7TOeRstqUVJFy4fFF" +
+	"qG+AbFCsahA1I7jm3sHHBx9n6jzpE9FpmswP/Y7rRF7FDNUnmKWiO0NNy/XH5+PTL4rGkfrkDma7fiqfHHyA7ToH1Rys43Nt8lkH" +
+	"1zhfhT8ph1JCMFvfWqzqtaVjf/u3f7t0Bkl8wEeYDAqh2wt/XTYxFTB5UVdYm5Q+R9uOkMsGWb9X2tVg0NaUdkzjt9xUprBQicCg" +
+	"/9WxmQooma9N1IeAGahTVpzDiVbW19qzBHz4y1rQgz9qdabyMUtLTz/bNvqBW67sVX334vdPnYQS0xKtJX7gS3bT0i2OTyaeUWN8" +
+	"IqcSejGqY2I8TtNZ8WPNtHU37Y9nBhk/eHpoDVihExEtSVRgv1MgamnG2E4HnrAncJApKfR33dbWVBrk7yZSbxhbwVx/di8z5bEe" +
+	"n463tDBamKl7NJ3pkJSZHSuZALOrwIx8OZ/f+FKZhASxOmQF58gXZnIKgxSyzwL+uhxbOnqyTRCuziHL8GVFviRT1EzlEo/FOhP4" +
+	"FxbC2iroeXNQ4GRVrtUuTKeT/VBAVcqFOdGzfTekuAQhR4KKJAmqR3uV3PzFNdewE/Hk+FR/zvePEpPcSwLMBpf46SKn9jq6bDrT" +
+	"YzttoreycaYYWXc6YixYZZeqXBgmjsIXzIGhNR04dZ1lwOnf6Z3DZ2oQFFac4AojuoHGFhxDjJxQ5gfg/ZdrDRArPrV4cnF63i8Q" +
+	"D4NwQUBgY15Lk3FHK6+wr8qtJ8qGVMJMQp1cIWaLFH800wHIoQ+5PFs1iDxV82adncIwHd08WRWj4aq36mbuzIYVUhQuThkFUidQ" +
+	"/caBwCz7C+GaPGhnBwNfKIAgRlzqWIHOyyoF7xRD3FQIXAIrcN0puEXZ11BH+sGkLFz0trVkAC/u5yR3ueVWg0e1FYicQpa4SUWL" +
+	"dNq5vsLtAc5I4oox2yokCJHl4hmO7KomM5RJKwAxK7yeJs9WjRov7MKsxMnivtOzG1YESRILmg7cZlOubISJZzCqcZ6qfSz1F6kY" +
+	"QH2HyFmkrRRCYONqVK652qwSsdn0AHXy1NA/LLx4YmFjfRwI7RBFagAHWPsGtjCZfTE21YM/9ABtNyevkZKjuA1gxyNuhN4xMCtH" +
+	"jHM90c4tGlDl0eEfCkTWjl43GFgmmM7Aceyt+6Jfu5Lm0de/9fTXv3WS59jXv/V0++jR5cmIFJyQGUkhfw7T6ocTdY+T+NqyKt3E" +
+	"Jo3Qy+k82z8slG7LPGUbN3cUNu3TfujmjprpEEw7O1bYskgWUrJ5JgpyUZUuvoMyjZCqVlYXPa+R646oPshLZ+k2CY6jsngzTCaO" +
+	"0Ov9qREDST+ZqVlzU8RCUP80cgxbOK7HPPVOxtJIHAGtdycbTwa+5Qfl3B3UikmcM9NWrNV2iPiXiIDQAkfcvnS/hLbICmvluhkn" +
+	"9vTx+U/tzgyDRh1H0UbN8hd8NfXyxEj9b90OvKiewsIop2cgGnUTldiTeGvol7aZziS3Z5ybM6y3No1lk41lmGH5HW5Nt687a+Lz" +
+	"ZiCCdKA/tjzj0EiMYUkwJSpXiTqJBzEDlm3QGGzC/GcAq4nMZwf+zdQkOC9G75OzH6ult1n5/twTR5fbGeN4LHWedtVSziFt3d0Z" +
+	"JneOP6SfJirUEVxCGCjUHCXOO3hfxWwli6EzHWTucVYbTo7CD7B75zMdRAGjTG9hJnFQwnVkHvT9RkHEopHBJiRen/S+ODEm8G9m" +
+	"Rh9w8jqIjQNtmeF4+kztSplumVwXcXxNeXQkpYbZ7viTlm4SVYp/GvlmMrMytPXLrp/Prb6IRfD+FUFnIojEfRq1etR1nNfK3KOJ" +
+	"AmmEEuCYbgKOCGiQ3VrtXCDLbgPynZHpai46yMmHUH0VM6KJBTvZRzOGqGtmOJCIwSFQAgKHoG24/ibgEDgjURYo4SuVOhXaK1s+" +
+	"uA1XYzYdlyH3Fe4vEw/PCy9pXZJ+mMOcou/T+/ELwS8x6xizauqAgAGphr6nDa9MLpFHYDBjf0E8JstCMT4IyfRHa6M/3AhmjnLr" +
+	"o6PCh7P/q70H++cw23Fz/8L0Z3vvYNbgHHIEUW7k3mTughDmew/2f4pZgM8ln7J/IWZBUg7hAXEGSfYBuHWmv8tY+cnP3qEsyv4F" +
+	"xLDvRow+5UN2IivP9P1+Tu2FFieunb1PmB3oJn6GfDjCb4Ixvw9Qef0Vjq99wpHCCwo7fp4+O/gQPsvPqBdPPwN7SSDrqxo1qhYm" +
+	"L91lOdZs15HZBL+ZFXME55ps9lPbDlhUE5Esig6FWXZWe9ZhoDDiaUuK1khLmSO43CaXX2eWkSJ/zCycVBUiTGCubaZsRrixqUqs" +
+	"YMtOuTmxbJVh+xNFGCqWTts8j6b7kaD9KmNmGeSThjZa81PHNJWkZEcX/W9yb1dXTvz7NeD9hOX4o6EvF7hsqzXLxiXSmm5OXkOH" +
+	"10Q4Qi5t6WsnTIpW528WFv4xJ/A44WtoSbc1o0dmWaZbX+y+88Xu7S92fwf/3vvpF7u/+mL3t19euPrlhX/58sI7X1741ZcX3vvy" +
+	"wm++vPDrrz587y+//0UsOb937i+//8VRyYvdlOQFZRnexbj9a5wC40SPZG244vKypNtiVelEBiemJ2JSIFah3pAi0F3OQl49m+7A" +
+	"X5eST64sjsWzv1Lpm4mE4BtSBP1Iclj/Ls27KPWkN1WSMVYNx3zldSn41Ymbc1Ol2Ve4wpSTYjqfNVGmvS3FvJe5aJ375Do0e/uR" +
+	"/OmGJMiuyJWPJOX3tvo69c95bLP+8IZU7+ZV8NzamyoLua0yRHHc6VvvyRc/kl699MX2u5lwafSCp9GEgD9QZoQqS5ua9vA/9k4m" +
+	"LQggW1GPkfob3LAm/ctZp367O/E7L5cZcfkzw0JdmzazCXop2T3TDqmaYMyKmWFDzbKHl7qTIQ3yahZn79zK40yLXm+kaXdtd2dt" +
+	"nxNuKFeDqt1duXgLS0s/+NbJpaWnTj9l/uE7p7/3XXNs8QmTO10SkZlweJgqa8mK2A26bnUFzw6t7PSUr6RIxt/bTfsCd/qsfgxP" +
+	"rs3y/8xEZ7FHi085MjGaR6ZjUAggyu/A42pmHlkrR74xIwPVmXlktiDRM28aYO6EV5/H1Juq+lLlaHkducyzmVv/7AWQH2fRQJgV" +
+	"KWp3be3tAg3BSrupR67d/b/udvBvZnIdFCtPLFMdujKPfqQcf5zaxw0wI82cqOuHDs2Kp9mmqttmsiXdmcf/zLeYfa66md2wUs98" +
+	"nFuZZaxoa7xfmSdrG3xxVHEt7u7dFQsardm7aCN/mvA7Ge/kbanGxNrLO8QeuX/2/+8Oe7t4hzf37jJ65z7Vnua7p3n+xSe/e+qk" +
+	"aZfNwukJIgMYvumYykn4O0RjGHam86u0E58ZFmXQfT97Ozj91OmnaO88ttBkN1Sm7env8yVLMyzkjebIsq6AVEcLhoqaZ6u+W6S6" +
+	"lScR/jpz/yjtS2F6zvZqH93Zo4uIh+fJO23D4hdwDk+fNdNRR/zzrEjXUldVU2dGfWf2aTEdtcJGqTNEU+7NwATgKpm9U05HR7CT" +
+	"7eJMZ2Vmtw7d+uzXZIWoydmi5wlOk/+0+MQxczo//WPC8a88s/NXhqdtZp9gM14e054rM/uwmTtiZp8Lf6W/2v3ZRkwAZHAxAw4x" +
+	"/f60NjqzzZNW66/8AdfS4nRPT61lXvkTZkQ8HP/KELb+Snd2Zu3T8F5/pdu6iM58U+1QvHsRBlNq59nrT8jHXUZRxjp0QF/iXvkI" +
+	"EY3xPoI12xVzNVrrVwTt9SsFK9vNMW5vKFYYsu6vKeBSROQ9UgQ/j/ieM8x5clyuIMUU4bZuClbr7Vl+xo3cE7osJE8PEfm1m1v3" +
+	"jB37/wYA"
@@ -5,51 +5,159 @@ import (
 	"compress/flate"
 	"compress/gzip"
 	"compress/lzw"
+	"errors"
+	"fmt"
 	"io"
 	"log"
+	"strings"
 )
 
 // HTTPBodyDecoderFactory selects which decoder should run.
 type HTTPBodyDecoderFactory struct {
-	rawDecoder         *RawHTTPDecoder
 	gzipDecoder        *GZipHTTPDecoder
 	compressDecoder    *CompressHTTPDecoder
 	deflateHTTPDecoder *DeflateHTTPDecoder
+	brotliDecoder      *BrotliHTTPDecoder
+	zstdDecoder        *ZstdHTTPDecoder
+	maxDecodedSize     int
+	maxDecodedRatio    float64
 }
 
-func (f *HTTPBodyDecoderFactory) create(encoding string) HTTPBodyDecoder {
-	if encoding == "gzip" {
-		return f.gzipDecoder
+var errDecodedBodyTooLarge = errors.New("decoded body size limit exceeded")
+
+// HTTPBodyDecoding is the outcome of decoding a body along its content codings.
+// Truncated is set when the decoded size limit was exceeded and Text holds only the beginning of the body.
+// Form bodies are parsed into Form instead of Text, FormIncomplete is set when only a part of the form
+// could be parsed, the rest of the body is withheld then.
+type HTTPBodyDecoding struct {
+	Text           string
+	Form           []FormField
+	FormIncomplete bool
+	Decoded        []string
+	Undecoded      []string
+	Truncated      bool
+}
+
+// decode undoes the content codings listed in a Content-Encoding header, last applied first.
+// It stops at the first unsupported coding or failure, the remaining codings are reported as undecoded.
+func (f *HTTPBodyDecoderFactory) decode(contentEncoding string, content *bytes.Buffer) *HTTPBodyDecoding {
+	if content.Len() == 0 {
+		return &HTTPBodyDecoding{}
 	}
-	if encoding == "compress" {
-		return f.compressDecoder
+
+	codings := parseContentCodings(contentEncoding)
+	limit := f.decodedLimit(content.Len())
+	text := content.String()
+	truncated := false
+	remaining := len(codings)
+	for remaining > 0 && !truncated {
+		decoder := f.create(codings[remaining-1])
+		if decoder == nil {
+			break
+		}
+		decoded, err := decoder.decode(bytes.NewBufferString(text), limit)
+		truncated = errors.Is(err, errDecodedBodyTooLarge)
+		if err != nil && !truncated {
+			text = ""
+			break
+		}
+		text = decoded
+		remaining--
 	}
-	if encoding == "deflate" {
+
+	return &HTTPBodyDecoding{
+		Text:      text,
+		Decoded:   codings[remaining:],
+		Undecoded: codings[:remaining],
+		Truncated: truncated,
+	}
+}
+
+// decodedLimit returns the maximum decoded size of a body (0 means unlimited),
+// the smaller of the absolute limit and the limit derived from the compression ratio.
+func (f *HTTPBodyDecoderFactory) decodedLimit(encodedSize int) int {
+	limit := f.maxDecodedSize
+	if f.maxDecodedRatio > 0 {
+		ratioLimit := int(f.maxDecodedRatio * float64(encodedSize))
+		if ratioLimit < 1 {
+			ratioLimit = 1
+		}
+		if limit <= 0 || ratioLimit < limit {
+			limit = ratioLimit
+		}
+	}
+	return limit
+}
+
+// create returns the decoder of a single content coding or nil when it is not supported.
+func (f *HTTPBodyDecoderFactory) create(coding string) HTTPBodyDecoder {
+	switch coding {
+	case "gzip", "x-gzip":
+		return f.gzipDecoder
+	case "compress", "x-compress":
+		return f.compressDecoder
+	case "deflate":
 		return f.deflateHTTPDecoder
+	case "br":
+		return f.brotliDecoder
+	case "zstd":
+		return f.zstdDecoder
+	default:
+		return nil
+	}
+}
+
+// parseContentCodings splits a Content-Encoding header into its codings in the order they were applied.
+// Codings are case-insensitive (RFC 9110), identity is dropped since it does not transform the content.
+func parseContentCodings(contentEncoding string) []string {
+	var codings []string
+	for _, coding := range strings.Split(contentEncoding, ",") {
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "" || coding == "identity" {
+			continue
+		}
+		codings = append(codings, coding)
 	}
-	return f.rawDecoder // identity and any unsupported encoding
+	return codings
 }
 
-func createHTTPBodyDecoderFactory(logger *log.Logger) *HTTPBodyDecoderFactory {
+func createHTTPBodyDecoderFactory(config *Config, logger *log.Logger) (*HTTPBodyDecoderFactory, error) {
+	if config.MaxDecodedBodySize < 0 {
+		return nil, fmt.Errorf("invalid maxDecodedBodySize: %d is negative", config.MaxDecodedBodySize)
+	}
+	if config.MaxDecodedBodyRatio != 0 && config.MaxDecodedBodyRatio < 1 {
+		return nil, fmt.Errorf("invalid maxDecodedBodyRatio: %v is less than 1", config.MaxDecodedBodyRatio)
+	}
 	return &HTTPBodyDecoderFactory{
-		rawDecoder:         &RawHTTPDecoder{},
 		gzipDecoder:        &GZipHTTPDecoder{logger: logger},
 		compressDecoder:    &CompressHTTPDecoder{logger: logger},
 		deflateHTTPDecoder: &DeflateHTTPDecoder{logger: logger},
-	}
+		brotliDecoder:      &BrotliHTTPDecoder{logger: logger},
+		zstdDecoder:        &ZstdHTTPDecoder{logger: logger},
+		maxDecodedSize:     config.MaxDecodedBodySize,
+		maxDecodedRatio:    config.MaxDecodedBodyRatio,
+	}, nil
 }
 
 // HTTPBodyDecoder a body decoder strategy.
 type HTTPBodyDecoder interface {
-	// decodes the content
-	decode(content *bytes.Buffer) (string, error)
+	// decodes the content, with a positive limit at most limit bytes are returned along with errDecodedBodyTooLarge
+	decode(content *bytes.Buffer, limit int) (string, error)
 }
 
-// RawHTTPDecoder just returns the content as-is.
-type RawHTTPDecoder struct{}
-
-func (d *RawHTTPDecoder) decode(content *bytes.Buffer) (string, error) {
-	return content.String(), nil
+// readLimited reads at most limit bytes (0 means unlimited) and returns errDecodedBodyTooLarge when there is more.
+func readLimited(reader io.Reader, limit int) ([]byte, error) {
+	if limit <= 0 {
+		return io.ReadAll(reader)
+	}
+	result, err := io.ReadAll(io.LimitReader(reader, int64(limit)+1))
+	if err != nil {
+		return result, err
+	}
+	if len(result) > limit {
+		return result[:limit], errDecodedBodyTooLarge
+	}
+	return result, nil
 }
 
 // GZipHTTPDecoder extracts the Lempel-Ziv coding (LZ77) with a 32-bit CRC.
@@ -57,14 +165,17 @@ type GZipHTTPDecoder struct {
 	logger *log.Logger
 }
 
-func (d *GZipHTTPDecoder) decode(content *bytes.Buffer) (string, error) {
+func (d *GZipHTTPDecoder) decode(content *bytes.Buffer, limit int) (string, error) {
 	gzReader, err := gzip.NewReader(content)
 	if err != nil {
 		d.logger.Printf("Failed to create gzip reader: %s", err)
 		return "", err
 	}
 	defer tryClose(gzReader, d.logger)
-	result, err := io.ReadAll(gzReader)
+	result, err := readLimited(gzReader, limit)
+	if errors.Is(err, errDecodedBodyTooLarge) {
+		return string(result), err
+	}
 	if err != nil {
 		d.logger.Printf("Failed to read gzip: %s", err)
 		return "", err
@@ -77,10 +188,13 @@ type CompressHTTPDecoder struct {
 	logger *log.Logger
 }
 
-func (d *CompressHTTPDecoder) decode(content *bytes.Buffer) (string, error) {
+func (d *CompressHTTPDecoder) decode(content *bytes.Buffer, limit int) (string, error) {
 	reader := lzw.NewReader(content, lzw.MSB, 8)
 	defer tryClose(reader, d.logger)
-	result, err := io.ReadAll(reader)
+	result, err := readLimited(reader, limit)
+	if errors.Is(err, errDecodedBodyTooLarge) {
+		return string(result), err
+	}
 	if err != nil {
 		d.logger.Printf("Failed to read compress: %s", err)
 		return "", err
@@ -93,13 +207,50 @@ type DeflateHTTPDecoder struct {
 	logger *log.Logger
 }
 
-func (d *DeflateHTTPDecoder) decode(content *bytes.Buffer) (string, error) {
+func (d *DeflateHTTPDecoder) decode(content *bytes.Buffer, limit int) (string, error) {
 	reader := flate.NewReader(content)
 	defer tryClose(reader, d.logger)
-	result, err := io.ReadAll(reader)
+	result, err := readLimited(reader, limit)
+	if errors.Is(err, errDecodedBodyTooLarge) {
+		return string(result), err
+	}
 	if err != nil {
 		d.logger.Printf("Failed to read deflate: %s", err)
 		return "", err
 	}
 	return string(result), nil
 }
+
+// BrotliHTTPDecoder extracts the Brotli compressed data format (LZ77 with context modeling and a static dictionary).
+type BrotliHTTPDecoder struct {
+	logger *log.Logger
+}
+
+func (d *BrotliHTTPDecoder) decode(content *bytes.Buffer, limit int) (string, error) {
+	result, err := decodeBrotli(content.Bytes(), limit)
+	if errors.Is(err, errDecodedBodyTooLarge) {
+		return string(result), err
+	}
+	if err != nil {
+		d.logger.Printf("Failed to read brotli: %s", err)
+		return "", err
+	}
+	return string(result), nil
+}
+
+// ZstdHTTPDecoder extracts the Zstandard compressed data format (LZ77 with Huffman and finite state entropy coding).
+type ZstdHTTPDecoder struct {
+	logger *log.Logger
+}
+
+func (d *ZstdHTTPDecoder) decode(content *bytes.Buffer, limit int) (string, error) {
+	result, err := decodeZstd(content.Bytes(), limit)
+	if errors.Is(err, errDecodedBodyTooLarge) {
+		return string(result), err
+	}
+	if err != nil {
+		d.logger.Printf("Failed to read zstd: %s", err)
+		return "", err
+	}
+	return string(result), nil
+}
@@ -0,0 +1,122 @@
+package traefiklogger
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// requestFilter decides which requests are logged before anything gets buffered.
+type requestFilter struct {
+	includePaths   []stringMatcher
+	excludePaths   []stringMatcher
+	includeMethods []string
+	excludeMethods []string
+}
+
+func createRequestFilter(config *Config) (*requestFilter, error) {
+	includePaths, err := compileMatchers(config.IncludePaths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid includePaths: %w", err)
+	}
+	excludePaths, err := compileMatchers(config.ExcludePaths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid excludePaths: %w", err)
+	}
+	return &requestFilter{
+		includePaths:   includePaths,
+		excludePaths:   excludePaths,
+		includeMethods: config.IncludeMethods,
+		excludeMethods: config.ExcludeMethods,
+	}, nil
+}
+
+// accepts returns true when the request has to be logged.
+// Excludes take precedence over includes, and empty include lists accept everything.
+func (f *requestFilter) accepts(r *http.Request) bool {
+	if containsFold(f.excludeMethods, r.Method) || matchAny(f.excludePaths, r.URL.Path) {
+		return false
+	}
+	if len(f.includeMethods) > 0 && !containsFold(f.includeMethods, r.Method) {
+		return false
+	}
+	if len(f.includePaths) > 0 && !matchAny(f.includePaths, r.URL.Path) {
+		return false
+	}
+	return true
+}
+
+// containsFold reports whether values contains value, ignoring case.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// statusRange is an inclusive range of HTTP status codes.
+type statusRange struct {
+	from int
+	to   int
+}
+
+// outcomeFilter decides which requests are logged once the response is known.
+type outcomeFilter struct {
+	statusRanges  []statusRange
+	minDurationMs float64
+}
+
+func createOutcomeFilter(config *Config) (*outcomeFilter, error) {
+	statusRanges := make([]statusRange, 0, len(config.StatusCodes))
+	for _, statusCodes := range config.StatusCodes {
+		sr, err := parseStatusRange(statusCodes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid statusCodes: %w", err)
+		}
+		statusRanges = append(statusRanges, sr)
+	}
+	return &outcomeFilter{
+		statusRanges:  statusRanges,
+		minDurationMs: config.MinDurationMs,
+	}, nil
+}
+
+// parseStatusRange parses a single status code (like "404") or an inclusive range (like "500-599").
+func parseStatusRange(value string) (statusRange, error) {
+	fromText, toText, isRange := strings.Cut(strings.TrimSpace(value), "-")
+	from, err := strconv.Atoi(strings.TrimSpace(fromText))
+	if err != nil {
+		return statusRange{}, err
+	}
+	if !isRange {
+		return statusRange{from: from, to: from}, nil
+	}
+	to, err := strconv.Atoi(strings.TrimSpace(toText))
+	if err != nil {
+		return statusRange{}, err
+	}
+	if to < from {
+		return statusRange{}, fmt.Errorf("empty status range: %s", value)
+	}
+	return statusRange{from: from, to: to}, nil
+}
+
+// accepts returns true when the request has to be logged.
+// Without any condition everything is accepted, otherwise it is enough to match either the status or the duration.
+func (f *outcomeFilter) accepts(status int, durationMs float64) bool {
+	if len(f.statusRanges) == 0 && f.minDurationMs <= 0 {
+		return true
+	}
+	if f.minDurationMs > 0 && durationMs >= f.minDurationMs {
+		return true
+	}
+	for _, sr := range f.statusRanges {
+		if status >= sr.from && status <= sr.to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package traefiklogger
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"strings"
+)
+
+// FormField is a decoded form field, file parts have a summary instead of their content.
+type FormField struct {
+	Name  string
+	Value string
+	File  *FormFile
+}
+
+// FormFile summarizes an uploaded file.
+type FormFile struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType,omitempty"`
+	Size        int    `json:"size"`
+}
+
+// parseForm decodes url-encoded and multipart form bodies, returns false for other content types.
+// A form which cannot be parsed completely, for example a truncated one, is returned with the fields
+// parsed so far and an error, so the rest of the body is never logged raw.
+func parseForm(contentType, body string) ([]FormField, bool, error) {
+	if body == "" {
+		return nil, false, nil
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, false, nil
+	}
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		fields, formErr := parseURLEncodedForm(body)
+		return fields, true, formErr
+	case "multipart/form-data":
+		fields, formErr := parseMultipartForm(body, params["boundary"])
+		return fields, true, formErr
+	default:
+		return nil, false, nil
+	}
+}
+
+// parseURLEncodedForm keeps the order of the fields, unlike url.ParseQuery.
+// The fields which cannot be unescaped are left out.
+func parseURLEncodedForm(body string) ([]FormField, error) {
+	var fields []FormField
+	var firstErr error
+	for _, pair := range strings.Split(body, "&") {
+		if pair == "" {
+			continue
+		}
+		field, err := parseURLEncodedField(pair)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields, firstErr
+}
+
+func parseURLEncodedField(pair string) (FormField, error) {
+	name, value, _ := strings.Cut(pair, "=")
+	name, err := url.QueryUnescape(name)
+	if err != nil {
+		return FormField{}, err
+	}
+	value, err = url.QueryUnescape(value)
+	if err != nil {
+		return FormField{}, err
+	}
+	return FormField{Name: name, Value: value}, nil
+}
+
+// parseMultipartForm stops at the first malformed part. A file part cut short is still summarized,
+// a value part cut short is left out.
+func parseMultipartForm(body, boundary string) ([]FormField, error) {
+	if boundary == "" {
+		return nil, errors.New("missing multipart boundary")
+	}
+	reader := multipart.NewReader(strings.NewReader(body), boundary)
+	var fields []FormField
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return fields, nil
+		}
+		if err != nil {
+			return fields, err
+		}
+		field, err := readFormPart(part)
+		if err != nil {
+			if field.File != nil {
+				fields = append(fields, field)
+			}
+			return fields, err
+		}
+		fields = append(fields, field)
+	}
+}
+
+func readFormPart(part *multipart.Part) (FormField, error) {
+	if part.FileName() == "" {
+		value, err := io.ReadAll(part)
+		return FormField{Name: part.FormName(), Value: string(value)}, err
+	}
+	size, err := io.Copy(io.Discard, part)
+	return FormField{
+		Name: part.FormName(),
+		File: &FormFile{
+			Filename:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Size:        int(size),
+		},
+	}, err
+}
+
+// formObject groups the fields by name for the JSON output, files are represented by their summary.
+func formObject(fields []FormField) map[string][]interface{} {
+	if len(fields) == 0 {
+		return nil
+	}
+	object := make(map[string][]interface{})
+	for _, field := range fields {
+		if field.File != nil {
+			object[field.Name] = append(object[field.Name], field.File)
+			continue
+		}
+		object[field.Name] = append(object[field.Name], field.Value)
+	}
+	return object
+}
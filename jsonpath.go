@@ -0,0 +1,345 @@
+package traefiklogger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// jsonPathSegment selects children of a node: by key, by index or all of them (wildcard).
+// A recursive segment applies to the node and all of its descendants.
+type jsonPathSegment struct {
+	key       string
+	index     int
+	wildcard  bool
+	recursive bool
+}
+
+// jsonPath is a compiled subset of JSONPath: $, .key, ['key'], [n], .*, [*] and ..key (recursive descent).
+type jsonPath []jsonPathSegment
+
+func compileJSONPath(expression string) (jsonPath, error) {
+	if !strings.HasPrefix(expression, "$") {
+		return nil, fmt.Errorf("json path must start with $: %s", expression)
+	}
+	var path jsonPath
+	rest := expression[1:]
+	for rest != "" {
+		var segment jsonPathSegment
+		var err error
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			segment, rest, err = parseJSONPathSegment(rest[2:])
+			segment.recursive = true
+		case strings.HasPrefix(rest, "."):
+			segment, rest, err = parseJSONPathSegment(rest[1:])
+		case strings.HasPrefix(rest, "["):
+			segment, rest, err = parseJSONPathSegment(rest)
+		default:
+			err = errors.New("unexpected character")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid json path %s: %w", expression, err)
+		}
+		path = append(path, segment)
+	}
+	return path, nil
+}
+
+// parseJSONPathSegment parses a member name, a wildcard or a bracket expression and returns the remaining expression.
+func parseJSONPathSegment(expression string) (jsonPathSegment, string, error) {
+	if strings.HasPrefix(expression, "[") {
+		return parseJSONPathBracket(expression)
+	}
+	end := strings.IndexAny(expression, ".[")
+	if end < 0 {
+		end = len(expression)
+	}
+	name := expression[:end]
+	if name == "" {
+		return jsonPathSegment{}, "", errors.New("empty member name")
+	}
+	if name == "*" {
+		return jsonPathSegment{wildcard: true}, expression[end:], nil
+	}
+	return jsonPathSegment{key: name, index: -1}, expression[end:], nil
+}
+
+func parseJSONPathBracket(expression string) (jsonPathSegment, string, error) {
+	if len(expression) > 1 && (expression[1] == '\'' || expression[1] == '"') {
+		end := strings.IndexByte(expression[2:], expression[1])
+		if end < 0 || !strings.HasPrefix(expression[2+end+1:], "]") {
+			return jsonPathSegment{}, "", errors.New("unterminated quoted member name")
+		}
+		return jsonPathSegment{key: expression[2 : 2+end], index: -1}, expression[2+end+2:], nil
+	}
+	end := strings.IndexByte(expression, ']')
+	if end < 0 {
+		return jsonPathSegment{}, "", errors.New("unterminated bracket")
+	}
+	content := expression[1:end]
+	if content == "*" {
+		return jsonPathSegment{wildcard: true}, expression[end+1:], nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil || index < 0 {
+		return jsonPathSegment{}, "", fmt.Errorf("invalid array index: %s", content)
+	}
+	return jsonPathSegment{index: index}, expression[end+1:], nil
+}
+
+// selectNodes returns the nodes of the document matched by the path.
+func (p jsonPath) selectNodes(root *jsonNode) []*jsonNode {
+	nodes := []*jsonNode{root}
+	for _, segment := range p {
+		var selected []*jsonNode
+		for _, node := range nodes {
+			if segment.recursive {
+				node.walk(func(descendant *jsonNode) {
+					selected = append(selected, segment.children(descendant)...)
+				})
+				continue
+			}
+			selected = append(selected, segment.children(node)...)
+		}
+		nodes = selected
+	}
+	return nodes
+}
+
+func (s jsonPathSegment) children(node *jsonNode) []*jsonNode {
+	switch {
+	case s.wildcard:
+		return node.values
+	case node.object && s.index < 0:
+		for i, key := range node.keys {
+			if key == s.key {
+				return []*jsonNode{node.values[i]}
+			}
+		}
+	case node.array:
+		if s.index >= 0 && s.index < len(node.values) {
+			return []*jsonNode{node.values[s.index]}
+		}
+	}
+	return nil
+}
+
+// jsonNode is a parsed JSON value which keeps the order of object members,
+// so a redacted body is logged in the same shape as it was sent.
+type jsonNode struct {
+	object bool
+	array  bool
+	keys   []string
+	values []*jsonNode
+	scalar interface{}
+}
+
+func parseJSONDocument(text string) (*jsonNode, error) {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	node, err := parseJSONNode(decoder)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return node, nil
+}
+
+func parseJSONNode(decoder *json.Decoder) (*jsonNode, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return &jsonNode{scalar: token}, nil
+	}
+	node := &jsonNode{object: delim == '{', array: delim == '['}
+	for decoder.More() {
+		if node.object {
+			if token, err = decoder.Token(); err != nil {
+				return nil, err
+			}
+			key, isKey := token.(string)
+			if !isKey {
+				return nil, errors.New("invalid object key")
+			}
+			node.keys = append(node.keys, key)
+		}
+		var value *jsonNode
+		if value, err = parseJSONNode(decoder); err != nil {
+			return nil, err
+		}
+		node.values = append(node.values, value)
+	}
+	if _, err = decoder.Token(); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// walk visits the node and all of its descendants.
+func (n *jsonNode) walk(visit func(*jsonNode)) {
+	visit(n)
+	for _, value := range n.values {
+		value.walk(visit)
+	}
+}
+
+// text returns the value of a string node or the JSON encoding of any other node.
+func (n *jsonNode) text() string {
+	if str, ok := n.scalar.(string); ok {
+		return str
+	}
+	return n.String()
+}
+
+// replace turns the node into a string value.
+func (n *jsonNode) replace(value string) {
+	*n = jsonNode{scalar: value}
+}
+
+// member returns the value of an object member or nil when there is no such member.
+func (n *jsonNode) member(key string) *jsonNode {
+	for i, k := range n.keys {
+		if k == key {
+			return n.values[i]
+		}
+	}
+	return nil
+}
+
+// memberText returns the text of an object member or empty string when there is no such member.
+func (n *jsonNode) memberText(key string) string {
+	if member := n.member(key); member != nil {
+		return member.text()
+	}
+	return ""
+}
+
+// findMember returns the object holding the member at the dotted path and the index of the member.
+// A member name may contain dots itself, like "log.level", so every matching prefix is tried.
+func (n *jsonNode) findMember(path string) (*jsonNode, int) {
+	for i, key := range n.keys {
+		if key == path {
+			return n, i
+		}
+		if strings.HasPrefix(path, key+".") && n.values[i].object {
+			if parent, index := n.values[i].findMember(path[len(key)+1:]); parent != nil {
+				return parent, index
+			}
+		}
+	}
+	return nil, -1
+}
+
+// removeMember removes the member at the dotted path and returns its value or nil when there is no such member.
+func (n *jsonNode) removeMember(path string) *jsonNode {
+	parent, index := n.findMember(path)
+	if parent == nil {
+		return nil
+	}
+	value := parent.values[index]
+	parent.keys = append(parent.keys[:index], parent.keys[index+1:]...)
+	parent.values = append(parent.values[:index], parent.values[index+1:]...)
+	return value
+}
+
+// removeEmptyParents removes the objects of the dotted path, from the deepest one, while they are empty.
+func (n *jsonNode) removeEmptyParents(path string) {
+	for index := strings.LastIndex(path, "."); index > 0; index = strings.LastIndex(path, ".") {
+		path = path[:index]
+		parent, i := n.findMember(path)
+		if parent == nil || !parent.values[i].object || len(parent.values[i].keys) > 0 {
+			return
+		}
+		parent.keys = append(parent.keys[:i], parent.keys[i+1:]...)
+		parent.values = append(parent.values[:i], parent.values[i+1:]...)
+	}
+}
+
+// setMember sets the member at the dotted path. The existing objects of the path are descended,
+// the rest of the path becomes a new member: nested objects when nested is true, a dotted name otherwise.
+func (n *jsonNode) setMember(path string, value *jsonNode, nested bool) {
+	for i, key := range n.keys {
+		if key == path {
+			n.values[i] = value
+			return
+		}
+		if strings.HasPrefix(path, key+".") && n.values[i].object {
+			n.values[i].setMember(path[len(key)+1:], value, nested)
+			return
+		}
+	}
+	if name, rest, found := strings.Cut(path, "."); found && nested {
+		child := &jsonNode{object: true}
+		child.setMember(rest, value, nested)
+		n.keys = append(n.keys, name)
+		n.values = append(n.values, child)
+		return
+	}
+	n.keys = append(n.keys, path)
+	n.values = append(n.values, value)
+}
+
+// mapStrings replaces every string value of the document, object keys are kept.
+func (n *jsonNode) mapStrings(mapping func(string) string) {
+	n.walk(func(node *jsonNode) {
+		if str, ok := node.scalar.(string); ok {
+			node.scalar = mapping(str)
+		}
+	})
+}
+
+// MarshalJSON keeps the order of object members.
+func (n *jsonNode) MarshalJSON() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+func (n *jsonNode) String() string {
+	var buf bytes.Buffer
+	n.write(&buf)
+	return buf.String()
+}
+
+func (n *jsonNode) write(buf *bytes.Buffer) {
+	switch {
+	case n.object:
+		buf.WriteByte('{')
+		for i, key := range n.keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeJSONScalar(buf, key)
+			buf.WriteByte(':')
+			n.values[i].write(buf)
+		}
+		buf.WriteByte('}')
+	case n.array:
+		buf.WriteByte('[')
+		for i, value := range n.values {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			value.write(buf)
+		}
+		buf.WriteByte(']')
+	default:
+		writeJSONScalar(buf, n.scalar)
+	}
+}
+
+func writeJSONScalar(buf *bytes.Buffer, value interface{}) {
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err == nil {
+		buf.Truncate(buf.Len() - 1) // Encode terminates the value with a newline.
+	}
+}
@@ -2,29 +2,179 @@ package traefiklogger
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
 	"strings"
+	"time"
 )
 
-func decodeJWTHeader(value string) string {
-	withBearer := strings.HasPrefix(value, "Bearer ")
-	var token string
-	if withBearer {
+// DecodedJWT is the structured form of a JSON Web Token, the signature is never logged.
+// The registered time claims are annotated with readable timestamps and their validity at request time.
+type DecodedJWT struct {
+	Header       *jsonNode `json:"header"`
+	Claims       *jsonNode `json:"claims"`
+	Verification string    `json:"verification,omitempty"`
+	IssuedAt     string    `json:"issuedAt,omitempty"`
+	NotBefore    string    `json:"notBefore,omitempty"`
+	ExpiresAt    string    `json:"expiresAt,omitempty"`
+	Expired      bool      `json:"expired,omitempty"`
+	NotYetValid  bool      `json:"notYetValid,omitempty"`
+
+	// valueIndex is the index of the header value holding the token.
+	valueIndex int
+}
+
+// jwtDecoder decodes the tokens of the configured headers, verifies their signature and keeps only the allowed claims.
+type jwtDecoder struct {
+	headers       []stringMatcher
+	allowedClaims []stringMatcher
+	deniedClaims  []stringMatcher
+	verifier      *jwtVerifier
+}
+
+func createJWTDecoder(config *Config) (*jwtDecoder, error) {
+	headers, err := compileHeaderMatchers(config.JWTHeaders)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwtHeaders: %w", err)
+	}
+	allowedClaims, err := compileMatchers(config.JWTClaimAllowlist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwtClaimAllowlist: %w", err)
+	}
+	deniedClaims, err := compileMatchers(config.JWTClaimDenylist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwtClaimDenylist: %w", err)
+	}
+	verifier, err := createJWTVerifier(config)
+	if err != nil {
+		return nil, err
+	}
+	return &jwtDecoder{headers: headers, allowedClaims: allowedClaims, deniedClaims: deniedClaims, verifier: verifier}, nil
+}
+
+// decodes returns true when the header carries a token to decode.
+func (d *jwtDecoder) decodes(key string) bool {
+	return matchHeader(d.headers, key)
+}
+
+// decode returns the readable header value and the structured token.
+// The token is nil when the value is not a JWT with JSON header and claims, the readable value is the best effort then.
+func (d *jwtDecoder) decode(value string, now time.Time) (string, *DecodedJWT) {
+	prefix := ""
+	token := value
+	if strings.HasPrefix(value, "Bearer ") {
+		prefix = "Bearer "
 		token = strings.TrimPrefix(value, "Bearer ")
-	} else {
-		token = value
 	}
 	parts := strings.Split(token, ".")
 	if len(parts) != 3 {
-		return value
+		return value, nil
 	}
 	decodedParts, err := decodeEach(parts[0:2], base64Decode)
 	if err != nil {
-		return value
+		return value, nil
+	}
+	header, err := parseJSONDocument(decodedParts[0])
+	if err != nil || !header.object {
+		return prefix + strings.Join(decodedParts, "."), nil
+	}
+	claims, err := parseJSONDocument(decodedParts[1])
+	if err != nil || !claims.object {
+		return prefix + strings.Join(decodedParts, "."), nil
+	}
+
+	decoded := &DecodedJWT{Header: header, Claims: claims}
+	decoded.Verification = d.verifier.verify(header, parts[0]+"."+parts[1], parts[2])
+	decoded.annotate(now)
+	if len(d.allowedClaims) > 0 || len(d.deniedClaims) > 0 {
+		d.filterClaims(claims)
+		decodedParts[1] = claims.String()
 	}
-	if withBearer {
-		return "Bearer " + strings.Join(decodedParts, ".")
+	text := prefix + strings.Join(decodedParts, ".")
+	if decoded.Verification != "" {
+		text += " (signature: " + decoded.Verification + ")"
+	}
+	return text, decoded
+}
+
+// headersWithoutJWTs returns the headers without the values decoded to tokens.
+// The structured formats log the tokens as objects, so the readable header value would only repeat them.
+func headersWithoutJWTs(headers http.Header, jwts map[string][]*DecodedJWT) http.Header {
+	if len(jwts) == 0 {
+		return headers
+	}
+	result := make(http.Header, len(headers))
+	for key, values := range headers {
+		kept := make([]string, 0, len(values))
+		for i, value := range values {
+			if !holdsJWT(jwts[key], i) {
+				kept = append(kept, value)
+			}
+		}
+		if len(kept) > 0 {
+			result[key] = kept
+		}
+	}
+	return result
+}
+
+func holdsJWT(tokens []*DecodedJWT, valueIndex int) bool {
+	for _, token := range tokens {
+		if token.valueIndex == valueIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// filterClaims drops the claims which are not allowed or are denied.
+func (d *jwtDecoder) filterClaims(claims *jsonNode) {
+	keys := claims.keys[:0]
+	values := claims.values[:0]
+	for i, key := range claims.keys {
+		if (len(d.allowedClaims) > 0 && !matchAny(d.allowedClaims, key)) || matchAny(d.deniedClaims, key) {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, claims.values[i])
+	}
+	claims.keys = keys
+	claims.values = values
+}
+
+// annotate converts the exp, nbf and iat claims to timestamps and checks them against the request time.
+func (t *DecodedJWT) annotate(now time.Time) {
+	if exp, ok := numericDateClaim(t.Claims, "exp"); ok {
+		t.ExpiresAt = exp.Format(time.RFC3339)
+		t.Expired = !now.Before(exp)
+	}
+	if nbf, ok := numericDateClaim(t.Claims, "nbf"); ok {
+		t.NotBefore = nbf.Format(time.RFC3339)
+		t.NotYetValid = now.Before(nbf)
+	}
+	if iat, ok := numericDateClaim(t.Claims, "iat"); ok {
+		t.IssuedAt = iat.Format(time.RFC3339)
+	}
+}
+
+// numericDateClaim returns a claim holding seconds since the epoch as time.
+func numericDateClaim(claims *jsonNode, name string) (time.Time, bool) {
+	claim := claims.member(name)
+	if claim == nil {
+		return time.Time{}, false
+	}
+	number, ok := claim.scalar.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
 	}
-	return strings.Join(decodedParts, ".")
+	whole, fraction := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(fraction*1e9)).UTC(), true
 }
 
 func base64Decode(encodedString string) (string, error) {
@@ -0,0 +1,263 @@
+package traefiklogger
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// Signature verification results of a JWT.
+const (
+	jwtValid          = "valid"
+	jwtBadSignature   = "bad-signature"
+	jwtUnknownKID     = "unknown-kid"
+	jwtAlgNone        = "alg-none"
+	jwtUnsupportedAlg = "unsupported-alg"
+)
+
+// jwtKey verifies the signature of the tokens signed with the algorithm, the ID is empty for keys without one.
+type jwtKey struct {
+	id     string
+	alg    string
+	verify func(signingInput, signature []byte) bool
+}
+
+// jwtVerifier checks the signature of the tokens against the configured keys.
+type jwtVerifier struct {
+	keys []jwtKey
+}
+
+func createJWTVerifier(config *Config) (*jwtVerifier, error) {
+	var keys []jwtKey
+	for _, secret := range config.JWTSecrets {
+		keys = append(keys, jwtKey{alg: "HS256", verify: verifyHS256([]byte(secret))})
+	}
+	for i, publicKey := range config.JWTPublicKeys {
+		key, err := parsePEMJWTKey(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwtPublicKeys[%d]: %w", i, err)
+		}
+		keys = append(keys, key)
+	}
+	if config.JWTKeySetFile != "" {
+		keySet, err := readJWKSFile(config.JWTKeySetFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwtKeySetFile: %w", err)
+		}
+		keys = append(keys, keySet...)
+	}
+	return &jwtVerifier{keys: keys}, nil
+}
+
+// verify returns the verification result of the token or empty string when no key is configured.
+// A key with ID is used only for the tokens with the same kid, a key without ID is used for any token.
+func (v *jwtVerifier) verify(header *jsonNode, signingInput, signature string) string {
+	if len(v.keys) == 0 {
+		return ""
+	}
+	alg := header.memberText("alg")
+	if alg == "" || strings.EqualFold(alg, "none") {
+		return jwtAlgNone
+	}
+	if !supportedJWTAlg(alg) {
+		return jwtUnsupportedAlg
+	}
+	kid := header.memberText("kid")
+	decodedSignature, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return jwtBadSignature
+	}
+	known := false
+	for _, key := range v.keys {
+		if key.alg != alg || (key.id != "" && key.id != kid) {
+			continue
+		}
+		known = true
+		if key.verify([]byte(signingInput), decodedSignature) {
+			return jwtValid
+		}
+	}
+	if !known {
+		return jwtUnknownKID
+	}
+	return jwtBadSignature
+}
+
+func supportedJWTAlg(alg string) bool {
+	return alg == "HS256" || alg == "RS256" || alg == "ES256"
+}
+
+func verifyHS256(secret []byte) func(signingInput, signature []byte) bool {
+	return func(signingInput, signature []byte) bool {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(signingInput)
+		return hmac.Equal(mac.Sum(nil), signature)
+	}
+}
+
+func verifyRS256(publicKey *rsa.PublicKey) func(signingInput, signature []byte) bool {
+	return func(signingInput, signature []byte) bool {
+		digest := sha256.Sum256(signingInput)
+		return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature) == nil
+	}
+}
+
+// verifyES256 checks the JWS form of the signature: the 32 byte R and S values concatenated.
+func verifyES256(publicKey *ecdsa.PublicKey) func(signingInput, signature []byte) bool {
+	return func(signingInput, signature []byte) bool {
+		if len(signature) != 64 {
+			return false
+		}
+		digest := sha256.Sum256(signingInput)
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(publicKey, digest[:], r, s)
+	}
+}
+
+// parsePEMJWTKey reads an RSA or P-256 public key from a PKIX or PKCS #1 public key or from a certificate.
+func parsePEMJWTKey(text string) (jwtKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(text)))
+	if block == nil {
+		return jwtKey{}, errors.New("no PEM data found")
+	}
+	var publicKey interface{}
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var certificate *x509.Certificate
+		if certificate, err = x509.ParseCertificate(block.Bytes); err == nil {
+			publicKey = certificate.PublicKey
+		}
+	default:
+		return jwtKey{}, fmt.Errorf("unsupported PEM block: %s", block.Type)
+	}
+	if err != nil {
+		return jwtKey{}, err
+	}
+	return publicJWTKey("", publicKey)
+}
+
+func publicJWTKey(id string, publicKey interface{}) (jwtKey, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return jwtKey{id: id, alg: "RS256", verify: verifyRS256(key)}, nil
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return jwtKey{}, errors.New("ES256 requires a P-256 key")
+		}
+		return jwtKey{id: id, alg: "ES256", verify: verifyES256(key)}, nil
+	default:
+		return jwtKey{}, fmt.Errorf("unsupported public key type: %T", publicKey)
+	}
+}
+
+// jsonWebKey is a key of a JWKS document (RFC 7517), the values are base64url encoded.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+// readJWKSFile reads the signing keys of a local JWKS file.
+// Encryption keys and keys for other algorithms than HS256, RS256 and ES256 are skipped,
+// like the keys of other types and curves, only a malformed supported key is an error.
+func readJWKSFile(path string) ([]jwtKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.Unmarshal(data, &keySet); err != nil {
+		return nil, err
+	}
+	var keys []jwtKey
+	for _, webKey := range keySet.Keys {
+		if webKey.Use == "enc" || (webKey.Alg != "" && !supportedJWTAlg(webKey.Alg)) || !webKey.supported() {
+			continue
+		}
+		key, keyErr := webKey.jwtKey()
+		if keyErr != nil {
+			return nil, fmt.Errorf("key %q: %w", webKey.Kid, keyErr)
+		}
+		if webKey.Alg != "" && webKey.Alg != key.alg {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// supported returns true for the key types and curves of HS256, RS256 and ES256.
+func (k jsonWebKey) supported() bool {
+	switch k.Kty {
+	case "oct", "RSA":
+		return true
+	case "EC":
+		return k.Crv == "P-256"
+	default:
+		return false
+	}
+}
+
+func (k jsonWebKey) jwtKey() (jwtKey, error) {
+	switch k.Kty {
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		return jwtKey{id: k.Kid, alg: "HS256", verify: verifyHS256(secret)}, nil
+	case "RSA":
+		values, err := decodeEach([]string{k.N, k.E}, base64Decode)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		publicKey := &rsa.PublicKey{
+			N: new(big.Int).SetBytes([]byte(values[0])),
+			E: int(new(big.Int).SetBytes([]byte(values[1])).Int64()),
+		}
+		return publicJWTKey(k.Kid, publicKey)
+	case "EC":
+		if k.Crv != "P-256" {
+			return jwtKey{}, fmt.Errorf("unsupported curve: %s", k.Crv)
+		}
+		values, err := decodeEach([]string{k.X, k.Y}, base64Decode)
+		if err != nil {
+			return jwtKey{}, err
+		}
+		publicKey := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes([]byte(values[0])),
+			Y:     new(big.Int).SetBytes([]byte(values[1])),
+		}
+		return publicJWTKey(k.Kid, publicKey)
+	default:
+		return jwtKey{}, fmt.Errorf("unsupported key type: %s", k.Kty)
+	}
+}
@@ -0,0 +1,92 @@
+// Package traefiklogger a Traefik HTTP logger plugin.
+package traefiklogger
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+)
+
+// CLFHTTPLogger an NCSA Common Log Format logger implementation, the combined variant adds the referer and the user agent.
+// The referer and the user agent are taken from the logged request headers, so they are redacted like the other headers.
+// The timestamp is the start of the request, like in the logs of Apache httpd.
+type CLFHTTPLogger struct {
+	logger      *log.Logger
+	writer      LogWriter
+	combined    bool
+	extraFields bool
+}
+
+func (chl *CLFHTTPLogger) print(record *LogRecord) {
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("%s - %s [%s] \"%s\" %d %s",
+		clfField(clfHost(record.RemoteAddr)), clfField(record.User),
+		record.StartTime.Format("02/Jan/2006:15:04:05 -0700"),
+		escapeCLF(record.Method+" "+record.URL+" "+record.Proto),
+		record.StatusCode, clfBytes(record.ResponseContentLength),
+	))
+
+	if chl.combined {
+		builder.WriteString(fmt.Sprintf(" \"%s\" \"%s\"",
+			clfField(record.RequestHeaders.Get("Referer")),
+			clfField(record.RequestHeaders.Get("User-Agent")),
+		))
+	}
+
+	if chl.extraFields {
+		builder.WriteString(fmt.Sprintf(" %.3f %s", record.DurationMs, clfField(record.LogID)))
+	}
+
+	builder.WriteString("\n")
+
+	err := chl.writer.Write(builder.String())
+	if err != nil {
+		chl.logger.Println("Failed to write:", err)
+		return
+	}
+}
+
+// clfHost drops the port of the remote address.
+func clfHost(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// clfField returns the escaped value or "-" for a missing value.
+func clfField(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return escapeCLF(value)
+}
+
+// clfBytes returns the size of the response body or "-" when no body was sent.
+func clfBytes(size int) string {
+	if size == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", size)
+}
+
+// escapeCLF escapes quotes, backslashes and non-printable characters like Apache httpd, so a field cannot break the line.
+func escapeCLF(value string) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"' || c == '\\':
+			builder.WriteByte('\\')
+			builder.WriteByte(c)
+		case c < ' ' || c >= 0x7f:
+			builder.WriteString(fmt.Sprintf(`\x%02x`, c))
+		default:
+			builder.WriteByte(c)
+		}
+	}
+	return builder.String()
+}
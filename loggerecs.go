@@ -0,0 +1,105 @@
+// Package traefiklogger a Traefik HTTP logger plugin.
+package traefiklogger
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ecsDocument builds a nested Elastic Common Schema document, empty values are left out.
+type ecsDocument struct {
+	root *jsonNode
+}
+
+// put sets the value at the dotted ECS field name unless the value is empty.
+func (d *ecsDocument) put(field string, value interface{}) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	switch string(encoded) {
+	case `""`, `0`, `false`, `null`, `{}`, `[]`:
+		return
+	}
+	d.putJSON(field, encoded)
+}
+
+// putRequired sets the value at the dotted ECS field name even if it is empty.
+func (d *ecsDocument) putRequired(field string, value interface{}) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	d.putJSON(field, encoded)
+}
+
+func (d *ecsDocument) putJSON(field string, encoded []byte) {
+	node, err := parseJSONDocument(string(encoded))
+	if err != nil {
+		return
+	}
+	d.root.setMember(field, node, true)
+}
+
+// createECSLogData maps the record to ECS fields. The data without ECS field, like the headers,
+// is logged under the traefiklogger object, so the ECS namespaces contain only ECS fields.
+func createECSLogData(record *LogRecord, now time.Time) *jsonNode {
+	doc := &ecsDocument{root: &jsonNode{object: true}}
+	doc.putRequired("@timestamp", now.UTC().Format("2006-01-02T15:04:05.999Z07:00"))
+	doc.put("log.level", "info")
+	doc.put("message", fmt.Sprintf("%s %s %s %d", record.Method, record.URL, record.Proto, record.StatusCode))
+	doc.put("ecs.version", "1.6.0")
+	doc.put("service.name", record.System)
+	doc.put("event.id", record.LogID)
+	doc.putRequired("event.duration", int64(math.Round(record.DurationMs*float64(time.Millisecond))))
+	doc.put("trace.id", record.TraceID)
+	doc.put("span.id", record.SpanID)
+
+	// The source.ip field must be an IP address, so it is left out when the remote address has no port.
+	doc.put("source.address", record.RemoteAddr)
+	if host, port, err := net.SplitHostPort(record.RemoteAddr); err == nil {
+		doc.put("source.ip", host)
+		if number, portErr := strconv.Atoi(port); portErr == nil {
+			doc.put("source.port", number)
+		}
+	}
+	doc.put("user.name", record.User)
+	doc.put("user_agent.original", record.RequestHeaders.Get("User-Agent"))
+
+	path, query, _ := strings.Cut(record.URL, "?")
+	doc.put("url.original", record.URL)
+	doc.put("url.path", path)
+	doc.put("url.query", query)
+
+	doc.put("http.version", strings.TrimPrefix(record.Proto, "HTTP/"))
+	doc.put("http.request.method", record.Method)
+	doc.put("http.request.referrer", record.RequestHeaders.Get("Referer"))
+	doc.put("http.request.body.content", record.RequestBodyDecoding.Text)
+	doc.put("http.request.body.bytes", record.RequestBodySize)
+	doc.putRequired("http.response.status_code", record.StatusCode)
+	doc.put("http.response.body.content", record.ResponseBodyDecoding.Text)
+	doc.put("http.response.body.bytes", record.ResponseContentLength)
+
+	putECSMessage(doc, "traefiklogger.request", record.RequestHeaders, record.RequestJWTs, record.RequestBodyDecoding, record.RequestBodyTruncated)
+	putECSMessage(doc, "traefiklogger.response", record.ResponseHeaders, record.ResponseJWTs, record.ResponseBodyDecoding, record.ResponseBodyTruncated)
+	doc.put("traefiklogger.masked_pii", record.MaskedPII)
+	return doc.root
+}
+
+// putECSMessage sets the data of a request or a response which has no ECS field: the headers, the tokens, the form and the body details.
+func putECSMessage(doc *ecsDocument, prefix string, headers http.Header, jwts map[string][]*DecodedJWT, decoding *HTTPBodyDecoding, truncated bool) {
+	doc.put(prefix+".headers", headersWithoutJWTs(headers, jwts))
+	doc.put(prefix+".jwt", jwts)
+	doc.put(prefix+".form", formObject(decoding.Form))
+	doc.put(prefix+".form_incomplete", decoding.FormIncomplete)
+	doc.put(prefix+".body.truncated", truncated)
+	doc.put(prefix+".body.decoded_encodings", decoding.Decoded)
+	doc.put(prefix+".body.undecoded_encodings", decoding.Undecoded)
+	doc.put(prefix+".body.decoded_truncated", decoding.Truncated)
+}
@@ -6,61 +6,190 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 )
 
 // JSONHTTPLogger a JSON logger implementation.
 type JSONHTTPLogger struct {
-	clock         LoggerClock
-	uuidGenerator UUIDGenerator
-	logger        *log.Logger
-	writer        LogWriter
+	clock  LoggerClock
+	logger *log.Logger
+	writer LogWriter
+	fields *jsonLogFields
 }
 
-func (jhl *JSONHTTPLogger) print(record *LogRecord) {
-	requestBodyText, _ := record.RequestBodyDecoder.decode(record.RequestBody)
-	responseBodyText, _ := record.ResponseBodyDecoder.decode(record.ResponseBody)
-	logData := struct {
-		Level                 string              `json:"log.level,omitempty"`
-		Time                  string              `json:"@timestamp"`
-		Message               string              `json:"message,omitempty"`
-		System                string              `json:"systemName,omitempty"`
-		RemoteAddr            string              `json:"remoteAddress,omitempty"`
-		Method                string              `json:"method"`
-		URL                   string              `json:"path"`
-		Status                int                 `json:"status"`
-		StatusText            string              `json:"statusText"`
-		Proto                 string              `json:"proto"`
-		DurationMs            float64             `json:"durationMs"`
-		RequestHeaders        map[string][]string `json:"requestHeaders,omitempty"`
-		RequestBody           string              `json:"requestBody,omitempty"`
-		ResponseHeaders       map[string][]string `json:"responseHeaders,omitempty"`
-		ResponseContentLength int                 `json:"responseContentLength"`
-		ResponseBody          string              `json:"responseBody,omitempty"`
-		EcsVersion            string              `json:"ecs.version,omitempty"`
-		LogID                 string              `json:"logId,omitempty"`
-	}{
-		Level:                 "info",
-		Time:                  jhl.clock.Now().UTC().Format("2006-01-02T15:04:05.999Z07:00"),
-		Message:               fmt.Sprintf("%s %s %s %d", record.Method, record.URL, record.Proto, record.StatusCode),
-		System:                record.System,
-		RemoteAddr:            record.RemoteAddr,
-		Method:                record.Method,
-		URL:                   record.URL,
-		Status:                record.StatusCode,
-		StatusText:            http.StatusText(record.StatusCode),
-		Proto:                 record.Proto,
-		DurationMs:            record.DurationMs,
-		RequestHeaders:        record.RequestHeaders,
-		RequestBody:           requestBodyText,
-		ResponseHeaders:       record.ResponseHeaders,
-		ResponseContentLength: record.ResponseContentLength,
-		ResponseBody:          responseBodyText,
-		EcsVersion:            "1.6.0",
-		LogID:                 jhl.uuidGenerator.Generate(),
+// JSONStyle specifies the layout of the JSON and logfmt formats.
+type JSONStyle string
+
+const (
+	// FlatJSONStyle indicates the flat fields of the original JSON format.
+	FlatJSONStyle JSONStyle = "flat"
+	// ECSJSONStyle indicates nested Elastic Common Schema fields.
+	ECSJSONStyle JSONStyle = "ecs"
+)
+
+// dropJSONField is the new name of the fields to leave out.
+const dropJSONField = "-"
+
+// jsonLogFields selects the layout of the JSON and logfmt formats and renames or drops fields of it.
+// The fields are addressed by their dotted names, like "http.request.method".
+type jsonLogFields struct {
+	style   JSONStyle
+	sources []string
+	targets []string
+}
+
+func createJSONLogFields(config *Config) (*jsonLogFields, error) {
+	switch config.JSONStyle {
+	case "", FlatJSONStyle, ECSJSONStyle:
+	default:
+		return nil, fmt.Errorf("invalid jsonStyle: %s", config.JSONStyle)
+	}
+	sources := make([]string, 0, len(config.JSONFields))
+	for source, target := range config.JSONFields {
+		if source == "" || target == "" {
+			return nil, fmt.Errorf("invalid jsonFields: empty field name, use %s to drop a field", dropJSONField)
+		}
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	targets := make([]string, len(sources))
+	for i, source := range sources {
+		targets[i] = config.JSONFields[source]
+	}
+	return &jsonLogFields{style: config.JSONStyle, sources: sources, targets: targets}, nil
+}
+
+// document returns the fields of the record. All the renamed fields are removed before any is added back,
+// so two fields can swap names. A new name is added to the deepest existing object of its path,
+// the objects left empty by the renamed fields are removed.
+func (f *jsonLogFields) document(record *LogRecord, now time.Time) (*jsonNode, error) {
+	var doc *jsonNode
+	if f.style == ECSJSONStyle {
+		doc = createECSLogData(record, now)
+	} else {
+		logBytes, err := json.Marshal(createJSONLogData(record, now))
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = parseJSONDocument(string(logBytes)); err != nil {
+			return nil, err
+		}
+	}
+	values := make([]*jsonNode, len(f.sources))
+	for i, source := range f.sources {
+		values[i] = doc.removeMember(source)
+	}
+	for i, target := range f.targets {
+		if values[i] != nil && target != dropJSONField {
+			doc.setMember(target, values[i], f.style == ECSJSONStyle)
+		}
+	}
+	for _, source := range f.sources {
+		doc.removeEmptyParents(source)
 	}
+	return doc, nil
+}
 
-	logBytes, err := json.Marshal(logData)
+// marshal returns the JSON log entry. The flat layout without renamed fields is encoded by encoding/json,
+// which escapes HTML characters unlike the document.
+func (f *jsonLogFields) marshal(record *LogRecord, now time.Time) ([]byte, error) {
+	if f.style != ECSJSONStyle && len(f.sources) == 0 {
+		return json.Marshal(createJSONLogData(record, now))
+	}
+	doc, err := f.document(record, now)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(doc.String()), nil
+}
+
+// jsonLogData is the JSON log entry, the logfmt format writes the same fields.
+type jsonLogData struct {
+	Level                  string                   `json:"log.level,omitempty"`
+	Time                   string                   `json:"@timestamp"`
+	Message                string                   `json:"message,omitempty"`
+	System                 string                   `json:"systemName,omitempty"`
+	RemoteAddr             string                   `json:"remoteAddress,omitempty"`
+	Method                 string                   `json:"method"`
+	URL                    string                   `json:"path"`
+	Status                 int                      `json:"status"`
+	StatusText             string                   `json:"statusText"`
+	Proto                  string                   `json:"proto"`
+	DurationMs             float64                  `json:"durationMs"`
+	RequestHeaders         map[string][]string      `json:"requestHeaders,omitempty"`
+	RequestJWT             map[string][]*DecodedJWT `json:"requestJwt,omitempty"`
+	RequestBody            string                   `json:"requestBody,omitempty"`
+	RequestForm            map[string][]interface{} `json:"requestForm,omitempty"`
+	RequestFormIncomplete  bool                     `json:"requestFormIncomplete,omitempty"`
+	RequestBodyTruncated   bool                     `json:"requestBodyTruncated,omitempty"`
+	RequestBodySize        int                      `json:"requestBodySize,omitempty"`
+	RequestBodyDecoded     []string                 `json:"requestBodyDecodedEncodings,omitempty"`
+	RequestBodyUndecoded   []string                 `json:"requestBodyUndecodedEncodings,omitempty"`
+	RequestBodyLimited     bool                     `json:"requestBodyDecodedTruncated,omitempty"`
+	ResponseHeaders        map[string][]string      `json:"responseHeaders,omitempty"`
+	ResponseJWT            map[string][]*DecodedJWT `json:"responseJwt,omitempty"`
+	ResponseContentLength  int                      `json:"responseContentLength"`
+	ResponseBody           string                   `json:"responseBody,omitempty"`
+	ResponseForm           map[string][]interface{} `json:"responseForm,omitempty"`
+	ResponseFormIncomplete bool                     `json:"responseFormIncomplete,omitempty"`
+	ResponseBodyTruncated  bool                     `json:"responseBodyTruncated,omitempty"`
+	ResponseBodySize       int                      `json:"responseBodySize,omitempty"`
+	ResponseBodyDecoded    []string                 `json:"responseBodyDecodedEncodings,omitempty"`
+	ResponseBodyUndecoded  []string                 `json:"responseBodyUndecodedEncodings,omitempty"`
+	ResponseBodyLimited    bool                     `json:"responseBodyDecodedTruncated,omitempty"`
+	EcsVersion             string                   `json:"ecs.version,omitempty"`
+	LogID                  string                   `json:"logId,omitempty"`
+	TraceID                string                   `json:"trace.id,omitempty"`
+	SpanID                 string                   `json:"span.id,omitempty"`
+	MaskedPII              map[string]int           `json:"maskedPii,omitempty"`
+}
+
+func createJSONLogData(record *LogRecord, now time.Time) *jsonLogData {
+	return &jsonLogData{
+		Level:                  "info",
+		Time:                   now.UTC().Format("2006-01-02T15:04:05.999Z07:00"),
+		Message:                fmt.Sprintf("%s %s %s %d", record.Method, record.URL, record.Proto, record.StatusCode),
+		System:                 record.System,
+		RemoteAddr:             record.RemoteAddr,
+		Method:                 record.Method,
+		URL:                    record.URL,
+		Status:                 record.StatusCode,
+		StatusText:             http.StatusText(record.StatusCode),
+		Proto:                  record.Proto,
+		DurationMs:             record.DurationMs,
+		RequestHeaders:         headersWithoutJWTs(record.RequestHeaders, record.RequestJWTs),
+		RequestJWT:             record.RequestJWTs,
+		RequestBody:            record.RequestBodyDecoding.Text,
+		RequestForm:            formObject(record.RequestBodyDecoding.Form),
+		RequestFormIncomplete:  record.RequestBodyDecoding.FormIncomplete,
+		RequestBodyTruncated:   record.RequestBodyTruncated,
+		RequestBodySize:        truncatedBodySize(record.RequestBodyTruncated, record.RequestBodySize),
+		RequestBodyDecoded:     record.RequestBodyDecoding.Decoded,
+		RequestBodyUndecoded:   record.RequestBodyDecoding.Undecoded,
+		RequestBodyLimited:     record.RequestBodyDecoding.Truncated,
+		ResponseHeaders:        headersWithoutJWTs(record.ResponseHeaders, record.ResponseJWTs),
+		ResponseJWT:            record.ResponseJWTs,
+		ResponseContentLength:  record.ResponseContentLength,
+		ResponseBody:           record.ResponseBodyDecoding.Text,
+		ResponseForm:           formObject(record.ResponseBodyDecoding.Form),
+		ResponseFormIncomplete: record.ResponseBodyDecoding.FormIncomplete,
+		ResponseBodyTruncated:  record.ResponseBodyTruncated,
+		ResponseBodySize:       truncatedBodySize(record.ResponseBodyTruncated, record.ResponseBodySize),
+		ResponseBodyDecoded:    record.ResponseBodyDecoding.Decoded,
+		ResponseBodyUndecoded:  record.ResponseBodyDecoding.Undecoded,
+		ResponseBodyLimited:    record.ResponseBodyDecoding.Truncated,
+		EcsVersion:             "1.6.0",
+		LogID:                  record.LogID,
+		TraceID:                record.TraceID,
+		SpanID:                 record.SpanID,
+		MaskedPII:              record.MaskedPII,
+	}
+}
+
+func (jhl *JSONHTTPLogger) print(record *LogRecord) {
+	logBytes, err := jhl.fields.marshal(record, jhl.clock.Now())
 	if err != nil {
 		jhl.logger.Println("Failed to marshal json log data")
 		return
@@ -76,3 +205,11 @@ func (jhl *JSONHTTPLogger) print(record *LogRecord) {
 		return
 	}
 }
+
+// truncatedBodySize reports the real body size only when it differs from the logged one.
+func truncatedBodySize(truncated bool, size int) int {
+	if truncated {
+		return size
+	}
+	return 0
+}
@@ -0,0 +1,110 @@
+// Package traefiklogger a Traefik HTTP logger plugin.
+package traefiklogger
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// LogfmtHTTPLogger a logfmt logger implementation.
+// It writes the fields of the JSON format in one line, nested objects are flattened to dotted keys.
+type LogfmtHTTPLogger struct {
+	clock  LoggerClock
+	logger *log.Logger
+	writer LogWriter
+	fields *jsonLogFields
+}
+
+func (lhl *LogfmtHTTPLogger) print(record *LogRecord) {
+	logData, err := lhl.fields.document(record, lhl.clock.Now())
+	if err != nil {
+		lhl.logger.Println("Failed to marshal logfmt log data")
+		return
+	}
+
+	var builder strings.Builder
+	writeLogfmtFields(&builder, "", logData)
+	builder.WriteString("\n")
+
+	err = lhl.writer.Write(builder.String())
+	if err != nil {
+		lhl.logger.Println("Failed to write:", err)
+		return
+	}
+}
+
+// writeLogfmtFields writes the members of the object as key=value pairs separated by spaces.
+func writeLogfmtFields(builder *strings.Builder, prefix string, object *jsonNode) {
+	for i, key := range object.keys {
+		value := object.values[i]
+		if value.object {
+			writeLogfmtFields(builder, prefix+key+".", value)
+			continue
+		}
+		if builder.Len() > 0 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString(logfmtKey(prefix + key))
+		builder.WriteByte('=')
+		writeLogfmtValue(builder, logfmtText(value))
+	}
+}
+
+// logfmtText joins the values of scalar arrays like repeated HTTP headers, other arrays are written as JSON.
+func logfmtText(value *jsonNode) string {
+	if !value.array {
+		return value.text()
+	}
+	texts := make([]string, len(value.values))
+	for i, element := range value.values {
+		if element.object || element.array {
+			return value.String()
+		}
+		texts[i] = element.text()
+	}
+	return strings.Join(texts, ", ")
+}
+
+// logfmtKey replaces the characters which would end the key.
+func logfmtKey(key string) string {
+	return strings.Map(func(c rune) rune {
+		if c <= ' ' || c == '=' || c == '"' || c == 0x7f {
+			return '_'
+		}
+		return c
+	}, key)
+}
+
+// writeLogfmtValue quotes the value when it is empty or contains spaces, quotes, equal signs or control characters.
+func writeLogfmtValue(builder *strings.Builder, value string) {
+	if value != "" && strings.IndexFunc(value, needsLogfmtQuote) < 0 {
+		builder.WriteString(value)
+		return
+	}
+	builder.WriteByte('"')
+	for _, c := range value {
+		switch c {
+		case '"', '\\':
+			builder.WriteByte('\\')
+			builder.WriteRune(c)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			if c < ' ' || c == 0x7f {
+				builder.WriteString(fmt.Sprintf(`\u%04x`, c))
+				continue
+			}
+			builder.WriteRune(c)
+		}
+	}
+	builder.WriteByte('"')
+}
+
+func needsLogfmtQuote(c rune) bool {
+	return c <= ' ' || c == '=' || c == '"' || c == '\\' || c == 0x7f
+}
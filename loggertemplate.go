@@ -0,0 +1,314 @@
+// Package traefiklogger a Traefik HTTP logger plugin.
+package traefiklogger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+// TemplateHTTPLogger a user-defined text/template logger implementation.
+type TemplateHTTPLogger struct {
+	clock    LoggerClock
+	logger   *log.Logger
+	writer   LogWriter
+	template *template.Template
+}
+
+// TemplateRecord is the data of the log template: the loggable data with decoded bodies.
+type TemplateRecord struct {
+	Time                  time.Time
+	LogID                 string
+	TraceID               string
+	SpanID                string
+	TraceFlags            string
+	System                string
+	RemoteAddr            string
+	User                  string
+	Method                string
+	URL                   string
+	Proto                 string
+	StatusCode            int
+	DurationMs            float64
+	RequestHeaders        http.Header
+	RequestJWTs           map[string][]*DecodedJWT
+	RequestBody           string
+	RequestForm           []FormField
+	RequestBodySize       int
+	RequestBodyTruncated  bool
+	ResponseHeaders       http.Header
+	ResponseJWTs          map[string][]*DecodedJWT
+	ResponseContentLength int
+	ResponseBody          string
+	ResponseForm          []FormField
+	ResponseBodySize      int
+	ResponseBodyTruncated bool
+	MaskedPII             map[string]int
+}
+
+// templateFuncs are the helpers of the log template.
+var templateFuncs = template.FuncMap{
+	"json":       templateJSON,
+	"jsonEscape": jsonEscape,
+	"header":     templateHeader,
+	"truncate":   truncateText,
+	"statusText": http.StatusText,
+	"formatTime": formatTime,
+}
+
+// compileLogTemplate parses the template and checks its field references,
+// because text/template reports the unknown fields only at execution.
+func compileLogTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, errors.New("empty template")
+	}
+	tmpl, err := template.New("log").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	root := reflect.TypeOf(&TemplateRecord{})
+	checker := &templateChecker{root: root}
+	if err = checker.check(tmpl.Tree.Root, root); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// templateChecker follows the type of dot through the template and checks that the referenced fields exist.
+// The type of a value returned by a function is unknown, so its fields are not checked.
+type templateChecker struct {
+	root reflect.Type
+}
+
+func (c *templateChecker) check(node parse.Node, dot reflect.Type) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := c.check(child, dot); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		_, err := c.pipeType(n.Pipe, dot)
+		return err
+	case *parse.TemplateNode:
+		_, err := c.pipeType(n.Pipe, dot)
+		return err
+	case *parse.IfNode:
+		return c.checkBranch(&n.BranchNode, dot, func(reflect.Type) reflect.Type { return dot })
+	case *parse.RangeNode:
+		return c.checkBranch(&n.BranchNode, dot, rangeElemType)
+	case *parse.WithNode:
+		return c.checkBranch(&n.BranchNode, dot, func(t reflect.Type) reflect.Type { return t })
+	}
+	return nil
+}
+
+// checkBranch checks the pipeline, the body with the dot returned by bodyDot and the else branch with the current dot.
+func (c *templateChecker) checkBranch(n *parse.BranchNode, dot reflect.Type, bodyDot func(reflect.Type) reflect.Type) error {
+	t, err := c.pipeType(n.Pipe, dot)
+	if err != nil {
+		return err
+	}
+	if err = c.check(n.List, bodyDot(t)); err != nil {
+		return err
+	}
+	return c.check(n.ElseList, dot)
+}
+
+// pipeType checks the arguments of the pipeline and returns its type, or nil when it is unknown.
+func (c *templateChecker) pipeType(pipe *parse.PipeNode, dot reflect.Type) (reflect.Type, error) {
+	if pipe == nil {
+		return nil, nil
+	}
+	var t reflect.Type
+	for _, cmd := range pipe.Cmds {
+		t = nil
+		for _, arg := range cmd.Args {
+			argType, err := c.argType(arg, dot)
+			if err != nil {
+				return nil, err
+			}
+			if len(cmd.Args) == 1 {
+				t = argType
+			}
+		}
+	}
+	if len(pipe.Decl) > 0 {
+		return nil, nil
+	}
+	return t, nil
+}
+
+func (c *templateChecker) argType(arg parse.Node, dot reflect.Type) (reflect.Type, error) {
+	switch n := arg.(type) {
+	case *parse.DotNode:
+		return dot, nil
+	case *parse.FieldNode:
+		return fieldType(dot, n.Ident)
+	case *parse.VariableNode:
+		if n.Ident[0] != "$" {
+			return nil, nil
+		}
+		return fieldType(c.root, n.Ident[1:])
+	case *parse.ChainNode:
+		t, err := c.argType(n.Node, dot)
+		if err != nil {
+			return nil, err
+		}
+		return fieldType(t, n.Field)
+	case *parse.PipeNode:
+		return c.pipeType(n, dot)
+	}
+	return nil, nil
+}
+
+// fieldType resolves the chain of fields and methods like text/template, an unknown type is not checked.
+func fieldType(t reflect.Type, names []string) (reflect.Type, error) {
+	for _, name := range names {
+		if t == nil || t.Kind() == reflect.Interface {
+			return nil, nil
+		}
+		if method, ok := t.MethodByName(name); ok {
+			t = methodResultType(method)
+			continue
+		}
+		if method, ok := reflect.PtrTo(t).MethodByName(name); ok && t.Kind() != reflect.Ptr {
+			t = methodResultType(method)
+			continue
+		}
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := t.FieldByName(name)
+			if !ok || field.PkgPath != "" {
+				return nil, fmt.Errorf("can't evaluate field %s in type %s", name, t)
+			}
+			t = field.Type
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return nil, fmt.Errorf("can't evaluate field %s in type %s", name, t)
+		}
+	}
+	return t, nil
+}
+
+func methodResultType(method reflect.Method) reflect.Type {
+	if method.Type.NumOut() == 0 {
+		return nil
+	}
+	return method.Type.Out(0)
+}
+
+// rangeElemType returns the type of dot inside the range body.
+func rangeElemType(t reflect.Type) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return t.Elem()
+	}
+	return nil
+}
+
+func (thl *TemplateHTTPLogger) print(record *LogRecord) {
+	var builder strings.Builder
+
+	err := thl.template.Execute(&builder, createTemplateRecord(record, thl.clock.Now()))
+	if err != nil {
+		thl.logger.Println("Failed to execute log template:", err)
+		return
+	}
+	if !strings.HasSuffix(builder.String(), "\n") {
+		builder.WriteString("\n")
+	}
+
+	err = thl.writer.Write(builder.String())
+	if err != nil {
+		thl.logger.Println("Failed to write:", err)
+		return
+	}
+}
+
+func createTemplateRecord(record *LogRecord, now time.Time) *TemplateRecord {
+	return &TemplateRecord{
+		Time:                  now,
+		LogID:                 record.LogID,
+		TraceID:               record.TraceID,
+		SpanID:                record.SpanID,
+		TraceFlags:            record.TraceFlags,
+		System:                record.System,
+		RemoteAddr:            record.RemoteAddr,
+		User:                  record.User,
+		Method:                record.Method,
+		URL:                   record.URL,
+		Proto:                 record.Proto,
+		StatusCode:            record.StatusCode,
+		DurationMs:            record.DurationMs,
+		RequestHeaders:        record.RequestHeaders,
+		RequestJWTs:           record.RequestJWTs,
+		RequestBody:           record.RequestBodyDecoding.Text,
+		RequestForm:           record.RequestBodyDecoding.Form,
+		RequestBodySize:       record.RequestBodySize,
+		RequestBodyTruncated:  record.RequestBodyTruncated,
+		ResponseHeaders:       record.ResponseHeaders,
+		ResponseJWTs:          record.ResponseJWTs,
+		ResponseContentLength: record.ResponseContentLength,
+		ResponseBody:          record.ResponseBodyDecoding.Text,
+		ResponseForm:          record.ResponseBodyDecoding.Form,
+		ResponseBodySize:      record.ResponseBodySize,
+		ResponseBodyTruncated: record.ResponseBodyTruncated,
+		MaskedPII:             record.MaskedPII,
+	}
+}
+
+// templateJSON returns the JSON encoding of the value.
+func templateJSON(value interface{}) (string, error) {
+	encoded, err := json.Marshal(value)
+	return string(encoded), err
+}
+
+// jsonEscape returns the text as the content of a JSON string, without the quotes.
+func jsonEscape(text string) string {
+	var buf bytes.Buffer
+	writeJSONScalar(&buf, text)
+	quoted := buf.String()
+	return quoted[1 : len(quoted)-1]
+}
+
+// templateHeader returns the values of the header joined by commas, the name is case-insensitive.
+func templateHeader(header http.Header, name string) string {
+	return strings.Join(header.Values(name), ", ")
+}
+
+// truncateText keeps the first length characters of the text, the arguments fit pipelines: {{.RequestBody | truncate 100}}.
+func truncateText(length int, text string) string {
+	if length < 0 {
+		return ""
+	}
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+	return string(runes[:length])
+}
+
+// formatTime formats the time with a Go layout, the arguments fit pipelines: {{.Time | formatTime "2006-01-02"}}.
+func formatTime(layout string, t time.Time) string {
+	return t.Format(layout)
+}
@@ -29,10 +29,7 @@ func (thl *TextualHTTPLogger) print(record *LogRecord) {
 	}
 
 	if record.RequestBody.Len() > 0 {
-		requestBodyText, _ := record.RequestBodyDecoder.decode(record.RequestBody)
-		builder.WriteString("\nRequest Body:\n")
-		builder.WriteString(requestBodyText)
-		builder.WriteString("\n")
+		writeBody(&builder, "Request", record.RequestBodyTruncated, record.RequestBodySize, record.RequestBodyDecoding)
 	}
 
 	if len(record.ResponseHeaders) > 0 {
@@ -43,11 +40,20 @@ func (thl *TextualHTTPLogger) print(record *LogRecord) {
 	builder.WriteString(fmt.Sprintf("\nResponse Content Length: %d\n", record.ResponseContentLength))
 	builder.WriteString(fmt.Sprintf("\nDuration: %.3f ms\n", record.DurationMs))
 
+	if record.LogID != "" {
+		builder.WriteString(fmt.Sprintf("\nLog ID: %s\n", record.LogID))
+	}
+
+	if record.TraceID != "" {
+		builder.WriteString(fmt.Sprintf("\nTrace: %s Span: %s Flags: %s\n", record.TraceID, record.SpanID, record.TraceFlags))
+	}
+
+	if len(record.MaskedPII) > 0 {
+		builder.WriteString(fmt.Sprintf("\nMasked PII: %s\n", formatCounts(record.MaskedPII)))
+	}
+
 	if record.ResponseBody.Len() > 0 {
-		responseBodyText, _ := record.ResponseBodyDecoder.decode(record.ResponseBody)
-		builder.WriteString("\nResponse Body:\n")
-		builder.WriteString(responseBodyText)
-		builder.WriteString("\n")
+		writeBody(&builder, "Response", record.ResponseBodyTruncated, record.ResponseBodySize, record.ResponseBodyDecoding)
 	}
 
 	builder.WriteString("\n")
@@ -59,6 +65,58 @@ func (thl *TextualHTTPLogger) print(record *LogRecord) {
 	}
 }
 
+// writeBody writes the decoded body text, or the fields of a form body.
+func writeBody(builder *strings.Builder, direction string, truncated bool, size int, decoding *HTTPBodyDecoding) {
+	if len(decoding.Form) == 0 && !decoding.FormIncomplete {
+		builder.WriteString(bodyTitle(direction+" Body", truncated, size, decoding))
+		builder.WriteString(decoding.Text)
+		builder.WriteString("\n")
+		return
+	}
+	builder.WriteString(bodyTitle(direction+" Form", truncated, size, decoding))
+	for _, field := range decoding.Form {
+		if field.File != nil {
+			builder.WriteString(fmt.Sprintf("%s: file %s (%s, %d bytes)\n", field.Name, field.File.Filename, field.File.ContentType, field.File.Size))
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("%s: %s\n", field.Name, field.Value))
+	}
+}
+
+func bodyTitle(title string, truncated bool, size int, decoding *HTTPBodyDecoding) string {
+	var notes []string
+	if truncated {
+		notes = append(notes, fmt.Sprintf("truncated, %d bytes total", size))
+	}
+	if decoding.Truncated {
+		notes = append(notes, "decoded size limit exceeded")
+	}
+	if len(decoding.Undecoded) > 0 {
+		notes = append(notes, "undecoded: "+strings.Join(decoding.Undecoded, ", "))
+	}
+	if decoding.FormIncomplete {
+		notes = append(notes, "unparsable rest withheld")
+	}
+	if len(notes) > 0 {
+		return fmt.Sprintf("\n%s (%s):\n", title, strings.Join(notes, "; "))
+	}
+	return fmt.Sprintf("\n%s:\n", title)
+}
+
+// formatCounts renders the counts ordered by name, like "card=1, email=2".
+func formatCounts(counts map[string]int) string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%d", name, counts[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
 func writeHeaders(builder *strings.Builder, header http.Header) {
 	keys := make([]string, 0, len(header))
 	for key := range header {
@@ -0,0 +1,124 @@
+package traefiklogger
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// regexPatternPrefix marks a pattern as a regular expression instead of a glob.
+const regexPatternPrefix = "regex:"
+
+// stringMatcher decides whether a value matches a configured pattern.
+type stringMatcher interface {
+	match(value string) bool
+}
+
+// exactMatcher matches the whole value.
+type exactMatcher struct {
+	value string
+}
+
+func (m *exactMatcher) match(value string) bool {
+	return m.value == value
+}
+
+// regexMatcher matches with a regular expression (globs are compiled to regular expressions too).
+type regexMatcher struct {
+	re *regexp.Regexp
+}
+
+func (m *regexMatcher) match(value string) bool {
+	return m.re.MatchString(value)
+}
+
+// compileMatcher compiles a pattern.
+// Patterns prefixed with "regex:" are regular expressions,
+// patterns containing '*' or '?' are globs where '*' matches any sequence and '?' matches a single character,
+// anything else is matched exactly.
+func compileMatcher(pattern string) (stringMatcher, error) {
+	if strings.HasPrefix(pattern, regexPatternPrefix) {
+		re, err := regexp.Compile(strings.TrimPrefix(pattern, regexPatternPrefix))
+		if err != nil {
+			return nil, err
+		}
+		return &regexMatcher{re: re}, nil
+	}
+	if strings.ContainsAny(pattern, "*?") {
+		return &regexMatcher{re: regexp.MustCompile(globToRegex(pattern))}, nil
+	}
+	return &exactMatcher{value: pattern}, nil
+}
+
+func compileMatchers(patterns []string) ([]stringMatcher, error) {
+	matchers := make([]stringMatcher, 0, len(patterns))
+	for _, pattern := range patterns {
+		matcher, err := compileMatcher(pattern)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, matcher)
+	}
+	return matchers, nil
+}
+
+// caseFoldMatcher matches the lower case form of the value, its pattern is compiled in lower case.
+type caseFoldMatcher struct {
+	matcher stringMatcher
+}
+
+func (m *caseFoldMatcher) match(value string) bool {
+	return m.matcher.match(strings.ToLower(value))
+}
+
+// compileHeaderMatchers compiles header name patterns, which are matched against canonical header names.
+// Exact names and globs ignore case, regular expressions see the canonical name, like X-Api-Key.
+func compileHeaderMatchers(patterns []string) ([]stringMatcher, error) {
+	matchers := make([]stringMatcher, 0, len(patterns))
+	for _, pattern := range patterns {
+		folded := !strings.HasPrefix(pattern, regexPatternPrefix)
+		if folded {
+			pattern = strings.ToLower(pattern)
+		}
+		matcher, err := compileMatcher(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if folded {
+			matcher = &caseFoldMatcher{matcher: matcher}
+		}
+		matchers = append(matchers, matcher)
+	}
+	return matchers, nil
+}
+
+// matchHeader returns true when the header name matches any of the header matchers.
+func matchHeader(matchers []stringMatcher, key string) bool {
+	return matchAny(matchers, http.CanonicalHeaderKey(key))
+}
+
+func matchAny(matchers []stringMatcher, value string) bool {
+	for _, matcher := range matchers {
+		if matcher.match(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func globToRegex(glob string) string {
+	var builder strings.Builder
+	builder.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			builder.WriteString(".*")
+		case '?':
+			builder.WriteString(".")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	builder.WriteString("$")
+	return builder.String()
+}
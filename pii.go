@@ -0,0 +1,174 @@
+package traefiklogger
+
+import (
+	"regexp"
+	"strings"
+)
+
+// piiKind describes a kind of personal data: where it may appear, how to confirm it and how to mask it.
+type piiKind struct {
+	name    string
+	pattern *regexp.Regexp
+	valid   func(match string) bool
+	mask    func(match string) string
+}
+
+// piiKinds are checked in order, masked characters are not matched again by the later kinds.
+var piiKinds = []piiKind{
+	{
+		name:    "iban",
+		pattern: regexp.MustCompile(`\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]){11,30}\b`),
+		valid:   isIBAN,
+		mask:    func(match string) string { return maskAlphanumerics(match, 2, 4) },
+	},
+	{
+		name:    "phone",
+		pattern: regexp.MustCompile(`\+[1-9][0-9]{7,14}\b`),
+		valid:   func(string) bool { return true },
+		mask:    func(match string) string { return maskAlphanumerics(match, 0, 4) },
+	},
+	{
+		name:    "card",
+		pattern: regexp.MustCompile(`\b(?:[0-9][ -]?){12,18}[0-9]\b`),
+		valid:   isLuhn,
+		mask:    func(match string) string { return maskAlphanumerics(match, 0, 4) },
+	},
+	{
+		name:    "email",
+		pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`),
+		valid:   func(string) bool { return true },
+		mask:    maskEmail,
+	},
+}
+
+// maskPII masks the personal data found in the text and counts the masked items by kind.
+func maskPII(text string, counts map[string]int) string {
+	for _, kind := range piiKinds {
+		text = kind.pattern.ReplaceAllStringFunc(text, func(match string) string {
+			if !kind.valid(match) {
+				return match
+			}
+			counts[kind.name]++
+			return kind.mask(match)
+		})
+	}
+	return text
+}
+
+// maskRecordPII masks the personal data in the URL, the user, the header values and the bodies of the record.
+func maskRecordPII(record *LogRecord) {
+	counts := make(map[string]int)
+	record.URL = maskPII(record.URL, counts)
+	record.User = maskPII(record.User, counts)
+	maskHeaderPII(record.RequestHeaders, counts)
+	maskHeaderPII(record.ResponseHeaders, counts)
+	maskJWTPII(record.RequestJWTs, counts)
+	maskJWTPII(record.ResponseJWTs, counts)
+	maskBodyPII(record.RequestBodyDecoding, counts)
+	maskBodyPII(record.ResponseBodyDecoding, counts)
+	if len(counts) > 0 {
+		record.MaskedPII = counts
+	}
+}
+
+func maskHeaderPII(header map[string][]string, counts map[string]int) {
+	for _, values := range header {
+		for i, value := range values {
+			values[i] = maskPII(value, counts)
+		}
+	}
+}
+
+func maskJWTPII(jwts map[string][]*DecodedJWT, counts map[string]int) {
+	for _, tokens := range jwts {
+		for _, token := range tokens {
+			token.Claims.mapStrings(func(value string) string {
+				return maskPII(value, counts)
+			})
+		}
+	}
+}
+
+func maskBodyPII(decoding *HTTPBodyDecoding, counts map[string]int) {
+	decoding.Text = maskPII(decoding.Text, counts)
+	for i := range decoding.Form {
+		decoding.Form[i].Value = maskPII(decoding.Form[i].Value, counts)
+	}
+}
+
+// isLuhn validates the check digit of a card number, separators are ignored.
+func isLuhn(number string) bool {
+	sum := 0
+	digits := 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if digits%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits >= 13 && digits <= 19 && sum%10 == 0
+}
+
+// isIBAN validates the ISO 13616 mod-97 checksum, spaces are ignored.
+func isIBAN(iban string) bool {
+	compact := strings.ReplaceAll(iban, " ", "")
+	if len(compact) < 15 || len(compact) > 34 {
+		return false
+	}
+	remainder := 0
+	for _, c := range compact[4:] + compact[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// maskAlphanumerics replaces letters and digits with '*' except the first and the last few, separators are kept.
+func maskAlphanumerics(text string, keepFirst, keepLast int) string {
+	total := 0
+	for _, c := range text {
+		if isAlphanumeric(c) {
+			total++
+		}
+	}
+	var builder strings.Builder
+	index := 0
+	for _, c := range text {
+		if !isAlphanumeric(c) {
+			builder.WriteRune(c)
+			continue
+		}
+		if index < keepFirst || index >= total-keepLast {
+			builder.WriteRune(c)
+		} else {
+			builder.WriteByte('*')
+		}
+		index++
+	}
+	return builder.String()
+}
+
+func isAlphanumeric(c rune) bool {
+	return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+}
+
+// maskEmail keeps the first character of the local part and the domain.
+func maskEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	return email[:1] + strings.Repeat("*", at-1) + email[at:]
+}
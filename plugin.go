@@ -11,23 +11,63 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
+	"time"
 )
 
 // Config the plugin configuration.
 type Config struct {
-	Enabled            bool      `json:"enabled"`
-	Debug              bool      `json:"debug"`
-	LogFormat          LogFormat `json:"logFormat"`
-	GenerateLogID      bool      `json:"generateLogId,omitempty"`
-	Name               string    `json:"name,omitempty"`
-	AcceptAny          bool      `json:"acceptAny,omitempty"`
-	SilentHeaders      bool      `json:"silentHeaders,omitempty"`
-	BodyContentTypes   []string  `json:"bodyContentTypes,omitempty"`
-	JWTHeaders         []string  `json:"jwtHeaders,omitempty"`
-	HeaderRedacts      []string  `json:"headerRedacts,omitempty"`
-	RequestBodyRedact  string    `json:"requestBodyRedact,omitempty"`
-	ResponseBodyRedact string    `json:"responseBodyRedact,omitempty"`
+	Enabled                 bool               `json:"enabled"`
+	Debug                   bool               `json:"debug"`
+	LogFormat               LogFormat          `json:"logFormat"`
+	GenerateLogID           bool               `json:"generateLogId,omitempty"`
+	Name                    string             `json:"name,omitempty"`
+	AcceptAny               bool               `json:"acceptAny,omitempty"`
+	SilentHeaders           bool               `json:"silentHeaders,omitempty"`
+	BodyContentTypes        []string           `json:"bodyContentTypes,omitempty"`
+	JWTHeaders              []string           `json:"jwtHeaders,omitempty"`
+	HeaderRedacts           []string           `json:"headerRedacts,omitempty"`
+	RequestBodyRedact       string             `json:"requestBodyRedact,omitempty"`
+	ResponseBodyRedact      string             `json:"responseBodyRedact,omitempty"`
+	MaxRequestBodySize      int                `json:"maxRequestBodySize,omitempty"`
+	MaxResponseBodySize     int                `json:"maxResponseBodySize,omitempty"`
+	IncludePaths            []string           `json:"includePaths,omitempty"`
+	ExcludePaths            []string           `json:"excludePaths,omitempty"`
+	IncludeMethods          []string           `json:"includeMethods,omitempty"`
+	ExcludeMethods          []string           `json:"excludeMethods,omitempty"`
+	StatusCodes             []string           `json:"statusCodes,omitempty"`
+	MinDurationMs           float64            `json:"minDurationMs,omitempty"`
+	SampleRate              float64            `json:"sampleRate"`
+	SampleKeyHeader         string             `json:"sampleKeyHeader,omitempty"`
+	SampleAlwaysErrors      bool               `json:"sampleAlwaysErrors,omitempty"`
+	SampleErrorStatus       int                `json:"sampleErrorStatus,omitempty"`
+	LogIDRequestHeader      string             `json:"logIdRequestHeader,omitempty"`
+	LogIDResponseHeader     string             `json:"logIdResponseHeader,omitempty"`
+	GenerateTraceContext    bool               `json:"generateTraceContext,omitempty"`
+	MaxDecodedBodySize      int                `json:"maxDecodedBodySize,omitempty"`
+	MaxDecodedBodyRatio     float64            `json:"maxDecodedBodyRatio,omitempty"`
+	RequestBodyJSONRedacts  []string           `json:"requestBodyJsonRedacts,omitempty"`
+	ResponseBodyJSONRedacts []string           `json:"responseBodyJsonRedacts,omitempty"`
+	RedactPatterns          []string           `json:"redactPatterns,omitempty"`
+	RedactReplacement       string             `json:"redactReplacement,omitempty"`
+	QueryRedacts            []string           `json:"queryRedacts,omitempty"`
+	CookieRedacts           []string           `json:"cookieRedacts,omitempty"`
+	FormFieldRedacts        []string           `json:"formFieldRedacts,omitempty"`
+	DetectPII               bool               `json:"detectPii,omitempty"`
+	RedactMode              RedactMode         `json:"redactMode,omitempty"`
+	RedactKey               string             `json:"redactKey,omitempty"`
+	HeaderAllowlist         []string           `json:"headerAllowlist,omitempty"`
+	UnlistedHeaders         UnlistedHeaderMode `json:"unlistedHeaders,omitempty"`
+	JWTClaimAllowlist       []string           `json:"jwtClaimAllowlist,omitempty"`
+	JWTClaimDenylist        []string           `json:"jwtClaimDenylist,omitempty"`
+	JWTSecrets              []string           `json:"jwtSecrets,omitempty"`
+	JWTPublicKeys           []string           `json:"jwtPublicKeys,omitempty"`
+	JWTKeySetFile           string             `json:"jwtKeySetFile,omitempty"`
+	CLFExtraFields          bool               `json:"clfExtraFields,omitempty"`
+	LogTemplate             string             `json:"logTemplate,omitempty"`
+	JSONStyle               JSONStyle          `json:"jsonStyle,omitempty"`
+	JSONFields              map[string]string  `json:"jsonFields,omitempty"`
 }
 
 // LogFormat specifies the log format.
@@ -38,6 +78,14 @@ const (
 	TextFormat LogFormat = "text"
 	// JSONFormat indicates JSON log format.
 	JSONFormat LogFormat = "json"
+	// LogfmtFormat indicates logfmt log format.
+	LogfmtFormat LogFormat = "logfmt"
+	// CLFFormat indicates NCSA Common Log Format.
+	CLFFormat LogFormat = "clf"
+	// CombinedFormat indicates NCSA Combined Log Format.
+	CombinedFormat LogFormat = "combined"
+	// TemplateFormat indicates a user-defined text/template log format.
+	TemplateFormat LogFormat = "template"
 )
 
 // NoOpMiddleware a no-op plugin implementation.
@@ -57,53 +105,116 @@ type HTTPLogger interface {
 
 // LogRecord contains the loggable data.
 type LogRecord struct {
+	LogID                 string
+	TraceID               string
+	SpanID                string
+	TraceFlags            string
 	System                string
 	Proto                 string
 	Method                string
 	URL                   string
 	RemoteAddr            string
+	User                  string
+	StartTime             time.Time
 	StatusCode            int
 	RequestHeaders        http.Header
+	RequestJWTs           map[string][]*DecodedJWT
 	RequestBody           *bytes.Buffer
+	RequestBodySize       int
+	RequestBodyTruncated  bool
 	ResponseHeaders       http.Header
+	ResponseJWTs          map[string][]*DecodedJWT
 	ResponseBody          *bytes.Buffer
+	ResponseBodySize      int
+	ResponseBodyTruncated bool
 	ResponseContentLength int
 	DurationMs            float64
-	RequestBodyDecoder    HTTPBodyDecoder
-	ResponseBodyDecoder   HTTPBodyDecoder
+	RequestBodyDecoding   *HTTPBodyDecoding
+	ResponseBodyDecoding  *HTTPBodyDecoding
+	MaskedPII             map[string]int
 }
 
 // LoggerMiddleware a Logger plugin.
 type LoggerMiddleware struct {
 	name                string
 	clock               LoggerClock
+	uuidGenerator       UUIDGenerator
 	logger              HTTPLogger
 	bodyDecoderFactory  *HTTPBodyDecoderFactory
 	acceptAny           bool
 	silentHeaders       bool
 	contentTypes        []string
-	jwtHeaders          []string
-	headerRedacts       []string
+	jwtDecoder          *jwtDecoder
+	redactor            *redactor
 	requestBodyRedacts  []string
 	responseBodyRedacts []string
+	requestJSONRedacts  []jsonBodyRedact
+	responseJSONRedacts []jsonBodyRedact
+	maxRequestBodySize  int
+	maxResponseBodySize int
+	requestFilter       *requestFilter
+	outcomeFilter       *outcomeFilter
+	sampler             *sampler
+	logIDRequestHeader  string
+	logIDResponseHeader string
+	generateTrace       bool
+	detectPII           bool
 	next                http.Handler
 }
 
 // CreateConfig creates the default plugin configuration.
 func CreateConfig() *Config {
 	return &Config{
-		Enabled:            true,
-		Debug:              false,
-		LogFormat:          TextFormat,
-		GenerateLogID:      true,
-		Name:               "HTTP",
-		AcceptAny:          false,
-		SilentHeaders:      false,
-		BodyContentTypes:   []string{},
-		JWTHeaders:         []string{},
-		HeaderRedacts:      []string{},
-		RequestBodyRedact:  "",
-		ResponseBodyRedact: "",
+		Enabled:                 true,
+		Debug:                   false,
+		LogFormat:               TextFormat,
+		GenerateLogID:           true,
+		Name:                    "HTTP",
+		AcceptAny:               false,
+		SilentHeaders:           false,
+		BodyContentTypes:        []string{},
+		JWTHeaders:              []string{},
+		HeaderRedacts:           []string{},
+		RequestBodyRedact:       "",
+		ResponseBodyRedact:      "",
+		MaxRequestBodySize:      0,
+		MaxResponseBodySize:     0,
+		IncludePaths:            []string{},
+		ExcludePaths:            []string{},
+		IncludeMethods:          []string{},
+		ExcludeMethods:          []string{},
+		StatusCodes:             []string{},
+		MinDurationMs:           0,
+		SampleRate:              1,
+		SampleKeyHeader:         "",
+		SampleAlwaysErrors:      false,
+		SampleErrorStatus:       400,
+		LogIDRequestHeader:      "",
+		LogIDResponseHeader:     "",
+		GenerateTraceContext:    false,
+		MaxDecodedBodySize:      10 << 20,
+		MaxDecodedBodyRatio:     100,
+		RequestBodyJSONRedacts:  []string{},
+		ResponseBodyJSONRedacts: []string{},
+		RedactPatterns:          []string{},
+		RedactReplacement:       defaultRedactReplacement,
+		QueryRedacts:            []string{},
+		CookieRedacts:           []string{},
+		FormFieldRedacts:        []string{},
+		DetectPII:               false,
+		RedactMode:              BlankRedactMode,
+		RedactKey:               "",
+		HeaderAllowlist:         []string{},
+		UnlistedHeaders:         DropUnlistedHeaders,
+		JWTClaimAllowlist:       []string{},
+		JWTClaimDenylist:        []string{},
+		JWTSecrets:              []string{},
+		JWTPublicKeys:           []string{},
+		JWTKeySetFile:           "",
+		CLFExtraFields:          false,
+		LogTemplate:             "",
+		JSONStyle:               FlatJSONStyle,
+		JSONFields:              map[string]string{},
 	}
 }
 
@@ -117,25 +228,94 @@ func New(ctx context.Context, next http.Handler, config *Config, name string) (h
 
 	logger := log.New(os.Stdout, "["+config.Name+"] ", log.LstdFlags)
 	if config.Debug {
-		logger.Printf("traefiklogger middleware config: %+v\n", config)
+		logger.Printf("traefiklogger middleware config: %+v\n", secretlessConfig(config))
+	}
+
+	requestFilter, err := createRequestFilter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomeFilter, err := createOutcomeFilter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	sampler, err := createSampler(config, createRandom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	bodyDecoderFactory, err := createHTTPBodyDecoderFactory(config, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	redactor, err := createRedactor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	jwtDecoder, err := createJWTDecoder(config)
+	if err != nil {
+		return nil, err
+	}
+
+	requestJSONRedacts, err := compileJSONBodyRedacts(config.RequestBodyJSONRedacts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid requestBodyJsonRedacts: %w", err)
+	}
+
+	responseJSONRedacts, err := compileJSONBodyRedacts(config.ResponseBodyJSONRedacts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid responseBodyJsonRedacts: %w", err)
+	}
+
+	httpLogger, err := createHTTPLogger(ctx, config, logger)
+	if err != nil {
+		return nil, err
 	}
 
 	return &LoggerMiddleware{
 		name:                config.Name,
 		clock:               createClock(ctx),
-		logger:              createHTTPLogger(ctx, config, logger),
-		bodyDecoderFactory:  createHTTPBodyDecoderFactory(logger),
+		uuidGenerator:       createUUIDGenerator(ctx, config),
+		logger:              httpLogger,
+		bodyDecoderFactory:  bodyDecoderFactory,
 		acceptAny:           config.AcceptAny,
 		silentHeaders:       config.SilentHeaders,
 		contentTypes:        config.BodyContentTypes,
-		jwtHeaders:          config.JWTHeaders,
-		headerRedacts:       config.HeaderRedacts,
+		jwtDecoder:          jwtDecoder,
+		redactor:            redactor,
 		requestBodyRedacts:  strings.Split(config.RequestBodyRedact, ";"),
 		responseBodyRedacts: strings.Split(config.ResponseBodyRedact, ";"),
+		requestJSONRedacts:  requestJSONRedacts,
+		responseJSONRedacts: responseJSONRedacts,
+		maxRequestBodySize:  config.MaxRequestBodySize,
+		maxResponseBodySize: config.MaxResponseBodySize,
+		requestFilter:       requestFilter,
+		outcomeFilter:       outcomeFilter,
+		sampler:             sampler,
+		logIDRequestHeader:  config.LogIDRequestHeader,
+		logIDResponseHeader: config.LogIDResponseHeader,
+		generateTrace:       config.GenerateTraceContext,
+		detectPII:           config.DetectPII,
 		next:                next,
 	}, nil
 }
 
+// secretlessConfig returns a copy of the config which is safe to print.
+func secretlessConfig(config *Config) *Config {
+	debugConfig := *config
+	if debugConfig.RedactKey != "" {
+		debugConfig.RedactKey = defaultRedactReplacement
+	}
+	if len(debugConfig.JWTSecrets) > 0 {
+		debugConfig.JWTSecrets = []string{defaultRedactReplacement}
+	}
+	return &debugConfig
+}
+
 func (m *LoggerMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Header.Get("Upgrade") == "websocket" {
 		m.next.ServeHTTP(w, r)
@@ -149,10 +329,32 @@ func (m *LoggerMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The log ID and the trace context are passed on even if the request is not logged.
+	logID := m.propagateLogID(w, r)
+	trace := m.resolveTraceContext(r)
+
+	if !m.requestFilter.accepts(r) {
+		m.next.ServeHTTP(w, r)
+		return
+	}
+
+	sampled := m.sampler.sample(r)
+	if !sampled && !m.sampler.alwaysErrors {
+		m.next.ServeHTTP(w, r)
+		return
+	}
+
+	m.serveAndLog(w, r, sampled, logID, trace)
+}
+
+// serveAndLog calls the next handler and logs the exchange.
+// Bodies are captured only for sampled requests, the others are logged only in case of error.
+func (m *LoggerMiddleware) serveAndLog(w http.ResponseWriter, r *http.Request, sampled bool, logID string, trace traceContext) {
 	mrc := &multiReadCloser{
 		rc:       r.Body,
 		buf:      &bytes.Buffer{},
-		withBody: !hasRedactedBody(r, m.requestBodyRedacts) && needToLogBody(m, r.Header.Get("Content-Type"), false),
+		limit:    m.maxRequestBodySize,
+		withBody: sampled && !hasRedactedBody(r, m.requestBodyRedacts) && needToLogBody(m, r.Header.Get("Content-Type"), false),
 	}
 	r.Body = mrc
 
@@ -160,43 +362,121 @@ func (m *LoggerMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		ResponseWriter: w,
 		status:         200, // Default is 200
 		body:           &bytes.Buffer{},
-		withBody:       !hasRedactedBody(r, m.responseBodyRedacts) && needToLogBody(m, r.Header.Get("Accept"), m.acceptAny),
+		limit:          m.maxResponseBodySize,
+		withBody:       sampled && !hasRedactedBody(r, m.responseBodyRedacts) && needToLogBody(m, r.Header.Get("Accept"), m.acceptAny),
 	}
 
-	requestHeaders := m.copyHeaders(r.Header)
-
+	// The headers are only cloned here, the JWT decoding and the redaction run only for the logged requests.
+	originalRequestHeaders := r.Header.Clone()
 	startTime := m.clock.Now()
+
 	m.next.ServeHTTP(mrw, r)
 	endTime := m.clock.Now()
 
 	originalResponseHeaders := w.Header()
-	responseHeaders := m.copyHeaders(originalResponseHeaders)
 	durationMs := float64(endTime.UnixMicro()-startTime.UnixMicro()) / 1000.0
 
-	requestBodyDecoder := m.bodyDecoderFactory.create(requestHeaders.Get("Content-Encoding"))
-	responseBodyDecoder := m.bodyDecoderFactory.create(originalResponseHeaders.Get("Content-Encoding"))
+	logged := sampled || m.sampler.keepsError(mrw.status)
+	if !logged || !m.outcomeFilter.accepts(mrw.status, durationMs) {
+		// The bodies were captured tentatively, release them.
+		mrc.discard()
+		mrw.discard()
+		return
+	}
+
+	requestHeaders, requestJWTs := m.copyHeaders(originalRequestHeaders, startTime)
+	responseHeaders, responseJWTs := m.copyHeaders(originalResponseHeaders, startTime)
+
 	responseBuffer := m.selectResponseBodyBuffer(mrw, originalResponseHeaders.Get("Content-Type"))
+	responseTruncated := mrw.truncated && responseBuffer == mrw.body
 
 	logRecord := &LogRecord{
+		LogID:                 logID,
+		TraceID:               trace.traceID,
+		SpanID:                trace.spanID,
+		TraceFlags:            trace.flags,
 		System:                m.name,
 		Proto:                 r.Proto,
 		Method:                r.Method,
-		URL:                   r.URL.String(),
+		URL:                   m.redactor.redactURL(r.URL.String()),
 		RemoteAddr:            r.RemoteAddr,
+		User:                  m.requestUser(r, requestJWTs),
+		StartTime:             startTime,
 		StatusCode:            mrw.status,
 		RequestHeaders:        requestHeaders,
+		RequestJWTs:           requestJWTs,
 		RequestBody:           mrc.buf,
+		RequestBodySize:       mrc.size,
+		RequestBodyTruncated:  mrc.truncated,
 		ResponseHeaders:       responseHeaders,
+		ResponseJWTs:          responseJWTs,
 		ResponseBody:          responseBuffer,
+		ResponseBodySize:      mrw.length,
+		ResponseBodyTruncated: responseTruncated,
 		ResponseContentLength: mrw.length,
 		DurationMs:            durationMs,
-		RequestBodyDecoder:    requestBodyDecoder,
-		ResponseBodyDecoder:   responseBodyDecoder,
+		RequestBodyDecoding:   m.decodeBody(r, originalRequestHeaders, mrc.buf, m.requestJSONRedacts),
+		ResponseBodyDecoding:  m.decodeBody(r, originalResponseHeaders, responseBuffer, m.responseJSONRedacts),
+	}
+
+	if m.detectPII {
+		maskRecordPII(logRecord)
 	}
 
 	m.logger.print(logRecord)
 }
 
+// decodeBody decodes the captured body and redacts the configured form fields, JSON fields and patterns.
+func (m *LoggerMiddleware) decodeBody(r *http.Request, header http.Header, body *bytes.Buffer, jsonRedacts []jsonBodyRedact) *HTTPBodyDecoding {
+	decoding := m.bodyDecoderFactory.decode(header.Get("Content-Encoding"), body)
+	if form, isForm, err := parseForm(header.Get("Content-Type"), decoding.Text); isForm {
+		m.redactor.redactForm(form)
+		decoding.Form = form
+		decoding.FormIncomplete = err != nil
+		decoding.Text = ""
+		return decoding
+	}
+	decoding.Text = m.redactor.redactJSONBody(r, header.Get("Content-Type"), decoding.Text, jsonRedacts)
+	decoding.Text = m.redactor.redactPatterns(decoding.Text)
+	return decoding
+}
+
+// propagateLogID reuses the incoming log ID or generates a new one,
+// then passes it to the backend and to the client in the configured headers.
+func (m *LoggerMiddleware) propagateLogID(w http.ResponseWriter, r *http.Request) string {
+	var logID string
+	if m.logIDRequestHeader != "" {
+		logID = r.Header.Get(m.logIDRequestHeader)
+	}
+	if logID == "" {
+		logID = m.uuidGenerator.Generate()
+		if logID != "" && m.logIDRequestHeader != "" {
+			r.Header.Set(m.logIDRequestHeader, logID)
+		}
+	}
+	if logID != "" && m.logIDResponseHeader != "" {
+		w.Header().Set(m.logIDResponseHeader, logID)
+	}
+	return logID
+}
+
+// resolveTraceContext returns the incoming trace context.
+// Without one, it optionally starts a new trace and passes it to the backend.
+func (m *LoggerMiddleware) resolveTraceContext(r *http.Request) traceContext {
+	if tc, ok := parseTraceContext(r.Header); ok {
+		return tc
+	}
+	if !m.generateTrace {
+		return traceContext{}
+	}
+	tc, err := newTraceContext()
+	if err != nil {
+		return traceContext{}
+	}
+	r.Header.Set("traceparent", tc.traceparent())
+	return tc
+}
+
 func needToLogBody(m *LoggerMiddleware, current string, acceptAny bool) bool {
 	for _, contentType := range m.contentTypes {
 		if acceptAny && (current == "" || current == "*/*") {
@@ -229,31 +509,100 @@ func (m *LoggerMiddleware) selectResponseBodyBuffer(mrw *multiResponseWriter, co
 	return &bytes.Buffer{}
 }
 
-func (m *LoggerMiddleware) copyHeaders(original http.Header) http.Header {
+// copyHeaders returns the loggable copy of the headers and the decoded JWTs by header name.
+// The validity of the tokens is checked at the given request time.
+func (m *LoggerMiddleware) copyHeaders(original http.Header, now time.Time) (http.Header, map[string][]*DecodedJWT) {
 	newHeader := make(http.Header)
+	jwts := make(map[string][]*DecodedJWT)
 	if m.silentHeaders {
-		return newHeader
+		return newHeader, jwts
 	}
 	for key, value := range original {
-		if containsIgnoreCase(m.headerRedacts, key) {
-			newHeader[key] = decodeHeaders(value, redact)
+		if !m.redactor.listsHeader(key) {
+			if m.redactor.unlistedHeaders == RedactUnlistedHeaders {
+				newHeader[key] = decodeHeaders(value, m.redactor.redact)
+			}
+			continue
+		}
+		if m.redactor.hidesHeader(key) {
+			newHeader[key] = decodeHeaders(value, m.redactor.redact)
 			continue
 		}
-		if containsIgnoreCase(m.jwtHeaders, key) {
-			newHeader[key] = decodeHeaders(value, decodeJWTHeader)
+		if m.jwtDecoder.decodes(key) {
+			newHeader[key] = m.decodeJWTs(value, now, jwts, key)
 			continue
 		}
-		newHeader[key] = value
+		newHeader[key] = decodeHeaders(value, func(v string) string {
+			return m.redactor.redactHeader(key, v)
+		})
+	}
+	return newHeader, jwts
+}
+
+// requestUser returns the user name of Basic authentication or the subject of the first decoded JWT.
+// The Basic user name is taken only when the Authorization header is logged and not redacted.
+func (m *LoggerMiddleware) requestUser(r *http.Request, jwts map[string][]*DecodedJWT) string {
+	logsAuthorization := !m.silentHeaders && m.redactor.listsHeader("Authorization") && !m.redactor.hidesHeader("Authorization")
+	if user, _, ok := r.BasicAuth(); ok && logsAuthorization {
+		return m.redactor.redactPatterns(user)
+	}
+	keys := make([]string, 0, len(jwts))
+	for key := range jwts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, token := range jwts[key] {
+			if subject := token.Claims.member("sub"); subject != nil {
+				return subject.text()
+			}
+		}
 	}
-	return newHeader
+	return ""
+}
+
+// decodeJWTs returns the readable header values and collects the structured tokens under the header name.
+func (m *LoggerMiddleware) decodeJWTs(values []string, now time.Time, jwts map[string][]*DecodedJWT, key string) []string {
+	decodedValues := make([]string, len(values))
+	for i, value := range values {
+		text, token := m.jwtDecoder.decode(value, now)
+		decodedValues[i] = m.redactor.redactPatterns(text)
+		if token != nil {
+			token.Claims.mapStrings(m.redactor.redactPatterns)
+			token.valueIndex = i
+			jwts[key] = append(jwts[key], token)
+		}
+	}
+	return decodedValues
+}
+
+// captureBody appends p to buf until buf reaches limit (0 means unlimited).
+// Returns true when any part of p had to be dropped.
+func captureBody(buf *bytes.Buffer, p []byte, limit int) bool {
+	if limit <= 0 {
+		buf.Write(p)
+		return false
+	}
+	remaining := limit - buf.Len()
+	if remaining <= 0 {
+		return len(p) > 0
+	}
+	if len(p) > remaining {
+		buf.Write(p[:remaining])
+		return true
+	}
+	buf.Write(p)
+	return false
 }
 
 type multiResponseWriter struct {
 	http.ResponseWriter
-	status   int
-	length   int
-	body     *bytes.Buffer
-	withBody bool
+	status    int
+	length    int
+	body      *bytes.Buffer
+	limit     int
+	truncated bool
+	withBody  bool
 }
 
 var _ http.ResponseWriter = (*multiResponseWriter)(nil)
@@ -266,12 +615,17 @@ func (w *multiResponseWriter) WriteHeader(status int) {
 func (w *multiResponseWriter) Write(b []byte) (int, error) {
 	n, err := w.ResponseWriter.Write(b)
 	w.length += n
-	if w.withBody {
-		w.body.Write(b)
+	if w.withBody && n > 0 && captureBody(w.body, b[:n], w.limit) {
+		w.truncated = true
 	}
 	return n, err
 }
 
+func (w *multiResponseWriter) discard() {
+	w.withBody = false
+	w.body = &bytes.Buffer{}
+}
+
 var _ http.Flusher = (*multiResponseWriter)(nil)
 
 func (w *multiResponseWriter) Flush() {
@@ -300,19 +654,28 @@ func (w *multiResponseWriter) Push(target string, opts *http.PushOptions) error
 }
 
 type multiReadCloser struct {
-	rc       io.ReadCloser
-	buf      *bytes.Buffer
-	withBody bool
+	rc        io.ReadCloser
+	buf       *bytes.Buffer
+	size      int
+	limit     int
+	truncated bool
+	withBody  bool
 }
 
 func (mrc *multiReadCloser) Read(p []byte) (int, error) {
 	n, err := mrc.rc.Read(p)
-	if mrc.withBody && n > 0 {
-		mrc.buf.Write(p[:n])
+	mrc.size += n
+	if mrc.withBody && n > 0 && captureBody(mrc.buf, p[:n], mrc.limit) {
+		mrc.truncated = true
 	}
 	return n, err
 }
 
+func (mrc *multiReadCloser) discard() {
+	mrc.withBody = false
+	mrc.buf = &bytes.Buffer{}
+}
+
 func (mrc *multiReadCloser) Close() error {
 	return mrc.rc.Close()
 }
@@ -3,7 +3,9 @@ package traefiklogger
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"math/rand"
 	"os"
 	"time"
 )
@@ -26,6 +28,24 @@ func (*SystemLoggerClock) Now() time.Time {
 	return time.Now()
 }
 
+type randomContextKey string
+
+// RandomContextKey can be used to fake sampling decisions.
+const RandomContextKey randomContextKey = "random"
+
+// LoggerRandom is the source of random numbers.
+type LoggerRandom interface {
+	Float64() float64
+}
+
+// MathLoggerRandom uses pseudo-random numbers.
+type MathLoggerRandom struct{}
+
+// Float64 returns a pseudo-random number in [0.0,1.0).
+func (*MathLoggerRandom) Float64() float64 {
+	return rand.Float64() //nolint:gosec // Sampling does not need a secure random source.
+}
+
 type uuidGeneratorContextKey string
 
 // UUIDGeneratorContextKey can be used to fake UUID generator.
@@ -82,12 +102,23 @@ func (w *LoggerLogWriter) Write(log string) error {
 	return nil
 }
 
-func createHTTPLogger(ctx context.Context, config *Config, logger *log.Logger) HTTPLogger {
+func createHTTPLogger(ctx context.Context, config *Config, logger *log.Logger) (HTTPLogger, error) {
 	switch config.LogFormat {
-	case JSONFormat:
-		return createJSONHTTPLogger(ctx, config, logger)
+	case JSONFormat, LogfmtFormat:
+		fields, err := createJSONLogFields(config)
+		if err != nil {
+			return nil, err
+		}
+		if config.LogFormat == LogfmtFormat {
+			return createLogfmtHTTPLogger(ctx, logger, fields), nil
+		}
+		return createJSONHTTPLogger(ctx, logger, fields), nil
+	case CLFFormat, CombinedFormat:
+		return createCLFHTTPLogger(ctx, logger, config.LogFormat == CombinedFormat, config.CLFExtraFields), nil
+	case TemplateFormat:
+		return createTemplateHTTPLogger(ctx, logger, config.LogTemplate)
 	default:
-		return createTextualHTTPLogger(ctx, logger)
+		return createTextualHTTPLogger(ctx, logger), nil
 	}
 }
 
@@ -99,14 +130,43 @@ func createTextualHTTPLogger(ctx context.Context, logger *log.Logger) *TextualHT
 	return &TextualHTTPLogger{logger: logger, writer: &LoggerLogWriter{logger: logger}}
 }
 
-func createJSONHTTPLogger(ctx context.Context, config *Config, logger *log.Logger) *JSONHTTPLogger {
+func createJSONHTTPLogger(ctx context.Context, logger *log.Logger, fields *jsonLogFields) *JSONHTTPLogger {
+	clock := createClock(ctx)
+	externalLogWriter, hasExternalLogWriter := ctx.Value(LogWriterContextKey).(LogWriter)
+	if hasExternalLogWriter {
+		return &JSONHTTPLogger{clock: clock, logger: logger, writer: externalLogWriter, fields: fields}
+	}
+	return &JSONHTTPLogger{clock: clock, logger: logger, writer: &FileLogWriter{file: os.Stdout}, fields: fields}
+}
+
+func createLogfmtHTTPLogger(ctx context.Context, logger *log.Logger, fields *jsonLogFields) *LogfmtHTTPLogger {
 	clock := createClock(ctx)
-	uuidGenerator := createUUIDGenerator(ctx, config)
 	externalLogWriter, hasExternalLogWriter := ctx.Value(LogWriterContextKey).(LogWriter)
 	if hasExternalLogWriter {
-		return &JSONHTTPLogger{clock: clock, uuidGenerator: uuidGenerator, logger: logger, writer: externalLogWriter}
+		return &LogfmtHTTPLogger{clock: clock, logger: logger, writer: externalLogWriter, fields: fields}
 	}
-	return &JSONHTTPLogger{clock: clock, uuidGenerator: uuidGenerator, logger: logger, writer: &FileLogWriter{file: os.Stdout}}
+	return &LogfmtHTTPLogger{clock: clock, logger: logger, writer: &FileLogWriter{file: os.Stdout}, fields: fields}
+}
+
+func createCLFHTTPLogger(ctx context.Context, logger *log.Logger, combined, extraFields bool) *CLFHTTPLogger {
+	externalLogWriter, hasExternalLogWriter := ctx.Value(LogWriterContextKey).(LogWriter)
+	if hasExternalLogWriter {
+		return &CLFHTTPLogger{logger: logger, writer: externalLogWriter, combined: combined, extraFields: extraFields}
+	}
+	return &CLFHTTPLogger{logger: logger, writer: &FileLogWriter{file: os.Stdout}, combined: combined, extraFields: extraFields}
+}
+
+func createTemplateHTTPLogger(ctx context.Context, logger *log.Logger, text string) (*TemplateHTTPLogger, error) {
+	tmpl, err := compileLogTemplate(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid logTemplate: %w", err)
+	}
+	clock := createClock(ctx)
+	externalLogWriter, hasExternalLogWriter := ctx.Value(LogWriterContextKey).(LogWriter)
+	if hasExternalLogWriter {
+		return &TemplateHTTPLogger{clock: clock, logger: logger, writer: externalLogWriter, template: tmpl}, nil
+	}
+	return &TemplateHTTPLogger{clock: clock, logger: logger, writer: &FileLogWriter{file: os.Stdout}, template: tmpl}, nil
 }
 
 func createUUIDGenerator(ctx context.Context, config *Config) UUIDGenerator {
@@ -127,3 +187,11 @@ func createClock(ctx context.Context) LoggerClock {
 	}
 	return &SystemLoggerClock{}
 }
+
+func createRandom(ctx context.Context) LoggerRandom {
+	externalRandom, hasExternalRandom := ctx.Value(RandomContextKey).(LoggerRandom)
+	if hasExternalRandom {
+		return externalRandom
+	}
+	return &MathLoggerRandom{}
+}
@@ -0,0 +1,350 @@
+package traefiklogger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	defaultRedactReplacement = "██"
+	// pseudonymLength is the number of hex digits kept from the HMAC, 64 bits are enough to tell values apart in logs.
+	pseudonymLength = 16
+)
+
+// RedactMode specifies how redacted values are replaced.
+type RedactMode string
+
+const (
+	// BlankRedactMode replaces values with the redact replacement.
+	BlankRedactMode RedactMode = "blank"
+	// HMACRedactMode replaces values with a truncated HMAC-SHA256 under the redact key,
+	// so equal values can be correlated without being revealed.
+	HMACRedactMode RedactMode = "hmac"
+)
+
+// UnlistedHeaderMode specifies what happens to the headers missing from the header allowlist.
+type UnlistedHeaderMode string
+
+const (
+	// DropUnlistedHeaders leaves the unlisted headers out of the log.
+	DropUnlistedHeaders UnlistedHeaderMode = "drop"
+	// RedactUnlistedHeaders logs the names of the unlisted headers with redacted values.
+	RedactUnlistedHeaders UnlistedHeaderMode = "redact"
+)
+
+// redactor hides sensitive values behind the replacement marker or a keyed pseudonym.
+type redactor struct {
+	replacement string
+	key         []byte
+	patterns    []*regexp.Regexp
+	queryParams []string
+	cookies     []stringMatcher
+	formFields  []stringMatcher
+	headers     []stringMatcher
+	// headerAllowlist lists the only headers logged as-is, empty means every header is logged.
+	headerAllowlist []stringMatcher
+	unlistedHeaders UnlistedHeaderMode
+}
+
+func createRedactor(config *Config) (*redactor, error) {
+	replacement := config.RedactReplacement
+	if replacement == "" {
+		replacement = defaultRedactReplacement
+	}
+	var key []byte
+	switch config.RedactMode {
+	case "", BlankRedactMode:
+	case HMACRedactMode:
+		if config.RedactKey == "" {
+			return nil, fmt.Errorf("redactKey is required in %s redactMode", HMACRedactMode)
+		}
+		key = []byte(config.RedactKey)
+	default:
+		return nil, fmt.Errorf("invalid redactMode: %s", config.RedactMode)
+	}
+	patterns := make([]*regexp.Regexp, 0, len(config.RedactPatterns))
+	for _, pattern := range config.RedactPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redactPatterns: %w", err)
+		}
+		patterns = append(patterns, re)
+	}
+	cookies, err := compileMatchers(config.CookieRedacts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cookieRedacts: %w", err)
+	}
+	formFields, err := compileMatchers(config.FormFieldRedacts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid formFieldRedacts: %w", err)
+	}
+	headers, err := compileHeaderMatchers(config.HeaderRedacts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid headerRedacts: %w", err)
+	}
+	headerAllowlist, err := compileHeaderMatchers(config.HeaderAllowlist)
+	if err != nil {
+		return nil, fmt.Errorf("invalid headerAllowlist: %w", err)
+	}
+	switch config.UnlistedHeaders {
+	case "", DropUnlistedHeaders, RedactUnlistedHeaders:
+	default:
+		return nil, fmt.Errorf("invalid unlistedHeaders: %s", config.UnlistedHeaders)
+	}
+	return &redactor{
+		replacement: replacement,
+		key:         key,
+		patterns:    patterns,
+		queryParams: config.QueryRedacts,
+		cookies:     cookies,
+		formFields:  formFields,
+		headers:     headers,
+
+		headerAllowlist: headerAllowlist,
+		unlistedHeaders: config.UnlistedHeaders,
+	}, nil
+}
+
+// redact replaces the whole value, empty values stay empty.
+func (rd *redactor) redact(text string) string {
+	if len(text) == 0 {
+		return ""
+	}
+	if rd.key != nil {
+		mac := hmac.New(sha256.New, rd.key)
+		mac.Write([]byte(text))
+		return hex.EncodeToString(mac.Sum(nil))[:pseudonymLength]
+	}
+	return rd.replacement
+}
+
+// listsHeader returns true when the header can be logged, which is always the case without an allowlist.
+func (rd *redactor) listsHeader(key string) bool {
+	return len(rd.headerAllowlist) == 0 || matchHeader(rd.headerAllowlist, key)
+}
+
+// hidesHeader returns true when the whole header value has to be redacted.
+func (rd *redactor) hidesHeader(key string) bool {
+	return matchHeader(rd.headers, key)
+}
+
+// redactHeader hides the configured cookie values and the pattern matches of a header value.
+func (rd *redactor) redactHeader(key, value string) string {
+	switch http.CanonicalHeaderKey(key) {
+	case "Cookie":
+		value = rd.redactCookies(value)
+	case "Set-Cookie":
+		value = rd.redactSetCookie(value)
+	}
+	return rd.redactPatterns(value)
+}
+
+// redactCookies replaces the values of the configured cookies in a Cookie header, keeping the cookie names.
+func (rd *redactor) redactCookies(value string) string {
+	if len(rd.cookies) == 0 {
+		return value
+	}
+	pairs := strings.Split(value, ";")
+	for i, pair := range pairs {
+		pairs[i] = rd.redactCookiePair(pair)
+	}
+	return strings.Join(pairs, ";")
+}
+
+// redactSetCookie replaces the value of a configured cookie in a Set-Cookie header,
+// attributes like Path, Secure, SameSite and Max-Age are kept.
+func (rd *redactor) redactSetCookie(value string) string {
+	if len(rd.cookies) == 0 {
+		return value
+	}
+	pair, attributes, hasAttributes := strings.Cut(value, ";")
+	pair = rd.redactCookiePair(pair)
+	if !hasAttributes {
+		return pair
+	}
+	return pair + ";" + attributes
+}
+
+func (rd *redactor) redactCookiePair(pair string) string {
+	name, value, found := strings.Cut(pair, "=")
+	if !found || !matchAny(rd.cookies, strings.TrimSpace(name)) {
+		return pair
+	}
+	return name + "=" + rd.redact(value)
+}
+
+// redactForm hides the values and file names of the configured form fields and the pattern matches of the other values.
+func (rd *redactor) redactForm(fields []FormField) {
+	for i := range fields {
+		field := &fields[i]
+		if !matchAny(rd.formFields, field.Name) {
+			field.Value = rd.redactPatterns(field.Value)
+			continue
+		}
+		field.Value = rd.redact(field.Value)
+		if field.File != nil {
+			field.File.Filename = rd.redact(field.File.Filename)
+		}
+	}
+}
+
+// redactURL hides the configured query parameter values and the pattern matches of a URL.
+func (rd *redactor) redactURL(rawURL string) string {
+	return rd.redactPatterns(rd.redactQuery(rawURL))
+}
+
+// redactQuery replaces the values of the configured query parameters (case-insensitive, every occurrence).
+// The rest of the URL is kept as it was sent.
+func (rd *redactor) redactQuery(rawURL string) string {
+	start := strings.IndexByte(rawURL, '?')
+	if len(rd.queryParams) == 0 || start < 0 {
+		return rawURL
+	}
+	end := strings.IndexByte(rawURL, '#')
+	if end < start {
+		end = len(rawURL)
+	}
+	params := strings.Split(rawURL[start+1:end], "&")
+	for i, param := range params {
+		name, value, found := strings.Cut(param, "=")
+		if !found {
+			continue
+		}
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+		if containsFold(rd.queryParams, name) {
+			params[i] = param[:len(param)-len(value)] + rd.redact(value)
+		}
+	}
+	return rawURL[:start+1] + strings.Join(params, "&") + rawURL[end:]
+}
+
+// redactPatterns replaces the matches of the patterns.
+// When a pattern has named capture groups, only those groups are replaced, so the context around the secret stays readable.
+func (rd *redactor) redactPatterns(text string) string {
+	for _, pattern := range rd.patterns {
+		text = rd.redactPattern(pattern, text)
+	}
+	return text
+}
+
+func (rd *redactor) redactPattern(pattern *regexp.Regexp, text string) string {
+	matches := pattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text
+	}
+	names := pattern.SubexpNames()
+	var builder strings.Builder
+	last := 0
+	for _, match := range matches {
+		for _, span := range sensitiveSpans(match, names) {
+			if span[0] < last {
+				continue // nested or overlapping group
+			}
+			builder.WriteString(text[last:span[0]])
+			builder.WriteString(rd.redact(text[span[0]:span[1]]))
+			last = span[1]
+		}
+	}
+	builder.WriteString(text[last:])
+	return builder.String()
+}
+
+// sensitiveSpans returns the spans of the participating named groups of a match, or the whole match without named groups.
+func sensitiveSpans(match []int, names []string) [][2]int {
+	var spans [][2]int
+	named := false
+	for i := 1; i < len(names); i++ {
+		if names[i] == "" {
+			continue
+		}
+		named = true
+		if match[2*i] >= 0 {
+			spans = append(spans, [2]int{match[2*i], match[2*i+1]})
+		}
+	}
+	if !named {
+		return [][2]int{{match[0], match[1]}}
+	}
+	return spans
+}
+
+// jsonBodyRedact redacts the values selected by a JSON path in the bodies of the matching routes.
+type jsonBodyRedact struct {
+	route string
+	path  jsonPath
+}
+
+// compileJSONBodyRedacts parses rules in the "[METHOD /prefix ]$.path" format.
+// The optional route prefix works the same as in requestBodyRedact, without it the rule applies to every route.
+func compileJSONBodyRedacts(rules []string) ([]jsonBodyRedact, error) {
+	redacts := make([]jsonBodyRedact, 0, len(rules))
+	for _, rule := range rules {
+		route := ""
+		expression := strings.TrimSpace(rule)
+		if !strings.HasPrefix(expression, "$") {
+			separator := strings.Index(expression, " $")
+			if separator < 0 {
+				return nil, fmt.Errorf("missing json path in rule: %s", rule)
+			}
+			route, expression = expression[:separator], expression[separator+1:]
+		}
+		path, err := compileJSONPath(expression)
+		if err != nil {
+			return nil, err
+		}
+		redacts = append(redacts, jsonBodyRedact{route: route, path: path})
+	}
+	return redacts, nil
+}
+
+// redactJSONBody replaces the selected values of a JSON body with the redact marker.
+// Only the bodies declared as JSON are redacted. A body which a rule applies to but cannot be parsed,
+// for example a truncated one, is replaced as a whole.
+func (rd *redactor) redactJSONBody(r *http.Request, contentType, body string, redacts []jsonBodyRedact) string {
+	if !isJSONMediaType(contentType) {
+		return body
+	}
+	method := r.Method + " " + r.URL.String()
+	var document *jsonNode
+	redacted := false
+	for _, rule := range redacts {
+		if !strings.HasPrefix(method, rule.route) {
+			continue
+		}
+		if document == nil {
+			var err error
+			if document, err = parseJSONDocument(body); err != nil {
+				if body == "" {
+					return body
+				}
+				return rd.replacement
+			}
+		}
+		for _, node := range rule.path.selectNodes(document) {
+			node.replace(rd.redact(node.text()))
+			redacted = true
+		}
+	}
+	if !redacted {
+		return body
+	}
+	return document.String()
+}
+
+// isJSONMediaType returns true for application/json and the structured syntax suffix +json, like application/problem+json.
+func isJSONMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
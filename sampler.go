@@ -0,0 +1,63 @@
+package traefiklogger
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+)
+
+// sampler decides which requests are logged when only a fraction of the traffic is affordable.
+type sampler struct {
+	rate         float64
+	keyHeader    string
+	alwaysErrors bool
+	errorStatus  int
+	random       LoggerRandom
+}
+
+func createSampler(config *Config, random LoggerRandom) (*sampler, error) {
+	if config.SampleRate < 0 || config.SampleRate > 1 {
+		return nil, fmt.Errorf("invalid sampleRate: %v is not between 0 and 1", config.SampleRate)
+	}
+	if config.SampleErrorStatus < 100 || config.SampleErrorStatus > 599 {
+		return nil, fmt.Errorf("invalid sampleErrorStatus: %d is not an HTTP status code", config.SampleErrorStatus)
+	}
+	return &sampler{
+		rate:         config.SampleRate,
+		keyHeader:    config.SampleKeyHeader,
+		alwaysErrors: config.SampleAlwaysErrors,
+		errorStatus:  config.SampleErrorStatus,
+		random:       random,
+	}, nil
+}
+
+// sample returns true when the request is selected for logging.
+// When the key header is present, the decision depends only on its value,
+// so every hop that sees the same key makes the same decision.
+func (s *sampler) sample(r *http.Request) bool {
+	if s.rate >= 1 {
+		return true
+	}
+	if s.rate <= 0 {
+		return false
+	}
+	if s.keyHeader != "" {
+		if key := r.Header.Get(s.keyHeader); key != "" {
+			return hashRatio(key) < s.rate
+		}
+	}
+	return s.random.Float64() < s.rate
+}
+
+// keepsError returns true when a request that was not sampled still has to be logged because of its status.
+// By default both client and server errors are kept.
+func (s *sampler) keepsError(status int) bool {
+	return s.alwaysErrors && status >= s.errorStatus
+}
+
+// hashRatio maps the key to a uniformly distributed number in [0, 1).
+func hashRatio(key string) float64 {
+	sum := sha256.Sum256([]byte(key))
+	return float64(binary.BigEndian.Uint64(sum[:8])>>11) / float64(uint64(1)<<53)
+}
@@ -0,0 +1,130 @@
+package traefiklogger
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// traceContext identifies the trace and the span of a request.
+type traceContext struct {
+	traceID string
+	spanID  string
+	flags   string
+}
+
+// parseTraceContext reads W3C Trace Context first, then falls back to B3 single and multi headers.
+func parseTraceContext(header http.Header) (traceContext, bool) {
+	if tc, ok := parseTraceparent(header.Get("traceparent")); ok {
+		return tc, true
+	}
+	if tc, ok := parseB3Single(header.Get("b3")); ok {
+		return tc, true
+	}
+	return parseB3Multi(header)
+}
+
+// parseTraceparent parses the "version-traceid-parentid-flags" format.
+// See https://www.w3.org/TR/trace-context/#traceparent-header
+func parseTraceparent(value string) (traceContext, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || !isHex(parts[0]) {
+		return traceContext{}, false
+	}
+	if parts[0] == "00" && len(parts) != 4 {
+		return traceContext{}, false
+	}
+	if !isTraceID(parts[1]) || !isSpanID(parts[2]) || len(parts[3]) != 2 || !isHex(parts[3]) {
+		return traceContext{}, false
+	}
+	return traceContext{traceID: parts[1], spanID: parts[2], flags: parts[3]}, true
+}
+
+// parseB3Single parses the "traceid-spanid-sampled-parentspanid" format where the last two parts are optional.
+// See https://github.com/openzipkin/b3-propagation#single-header
+func parseB3Single(value string) (traceContext, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 2 {
+		return traceContext{}, false
+	}
+	traceID := padB3TraceID(strings.ToLower(parts[0]))
+	spanID := strings.ToLower(parts[1])
+	if !isTraceID(traceID) || !isSpanID(spanID) {
+		return traceContext{}, false
+	}
+	sampled := ""
+	if len(parts) > 2 {
+		sampled = parts[2]
+	}
+	return traceContext{traceID: traceID, spanID: spanID, flags: b3Flags(sampled, "")}, true
+}
+
+// parseB3Multi parses the X-B3-* headers.
+// See https://github.com/openzipkin/b3-propagation#multiple-headers
+func parseB3Multi(header http.Header) (traceContext, bool) {
+	traceID := padB3TraceID(strings.ToLower(header.Get("X-B3-TraceId")))
+	spanID := strings.ToLower(header.Get("X-B3-SpanId"))
+	if !isTraceID(traceID) || !isSpanID(spanID) {
+		return traceContext{}, false
+	}
+	return traceContext{traceID: traceID, spanID: spanID, flags: b3Flags(header.Get("X-B3-Sampled"), header.Get("X-B3-Flags"))}, true
+}
+
+// b3Flags converts the B3 sampling state to W3C trace flags.
+func b3Flags(sampled, debug string) string {
+	if sampled == "1" || sampled == "d" || strings.EqualFold(sampled, "true") || debug == "1" {
+		return "01"
+	}
+	return "00"
+}
+
+// padB3TraceID left-pads 64-bit B3 trace IDs to 128-bit.
+func padB3TraceID(traceID string) string {
+	if len(traceID) == 16 {
+		return "0000000000000000" + traceID
+	}
+	return traceID
+}
+
+// newTraceContext starts a new sampled trace with random IDs.
+func newTraceContext() (traceContext, error) {
+	traceID, err := randomHex(16)
+	if err != nil {
+		return traceContext{}, err
+	}
+	spanID, err := randomHex(8)
+	if err != nil {
+		return traceContext{}, err
+	}
+	return traceContext{traceID: traceID, spanID: spanID, flags: "01"}, nil
+}
+
+func (tc traceContext) traceparent() string {
+	return "00-" + tc.traceID + "-" + tc.spanID + "-" + tc.flags
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func isTraceID(value string) bool {
+	return len(value) == 32 && isHex(value) && strings.Trim(value, "0") != ""
+}
+
+func isSpanID(value string) bool {
+	return len(value) == 16 && isHex(value) && strings.Trim(value, "0") != ""
+}
+
+func isHex(value string) bool {
+	for _, c := range value {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
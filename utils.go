@@ -3,25 +3,8 @@ package traefiklogger
 import (
 	"io"
 	"log"
-	"strings"
 )
 
-func containsIgnoreCase(values []string, value string) bool {
-	for _, str := range values {
-		if strings.Contains(strings.ToLower(str), strings.ToLower(value)) {
-			return true
-		}
-	}
-	return false
-}
-
-func redact(text string) string {
-	if len(text) == 0 {
-		return ""
-	}
-	return "██"
-}
-
 func decodeEach(value []string, decoder func(string) (string, error)) ([]string, error) {
 	decodedValues := make([]string, len(value))
 	for i, v := range value {
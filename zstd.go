@@ -0,0 +1,828 @@
+package traefiklogger
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Zstandard decompressor written after RFC 8878 (https://www.rfc-editor.org/rfc/rfc8878).
+// Dictionaries are not supported and the optional content checksum is not verified.
+
+var (
+	errZstdCorrupt    = errors.New("zstd: corrupt input")
+	errZstdDictionary = errors.New("zstd: dictionaries are not supported")
+)
+
+const (
+	zstdMagicNumber          = 0xFD2FB528
+	zstdSkippableMagicMask   = 0xFFFFFFF0
+	zstdSkippableMagicNumber = 0x184D2A50
+	zstdMaxHuffmanBits       = 11
+)
+
+var zstdLiteralLengthBase = [36]int{
+	0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+	16, 18, 20, 22, 24, 28, 32, 40, 48, 64, 128, 256, 512, 1024, 2048, 4096,
+	8192, 16384, 32768, 65536,
+}
+
+var zstdLiteralLengthBits = [36]uint{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 1, 1, 2, 2, 3, 3, 4, 6, 7, 8, 9, 10, 11, 12,
+	13, 14, 15, 16,
+}
+
+var zstdMatchLengthBase = [53]int{
+	3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
+	19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34,
+	35, 37, 39, 41, 43, 47, 51, 59, 67, 83, 99, 131, 259, 515, 1027, 2051,
+	4099, 8195, 16387, 32771, 65539,
+}
+
+var zstdMatchLengthBits = [53]uint{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1, 1, 1, 1, 2, 2, 3, 3, 4, 4, 5, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16,
+}
+
+var zstdLiteralLengthDefault = []int{
+	4, 3, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+	-1, -1, -1, -1,
+}
+
+var zstdMatchLengthDefault = []int{
+	1, 4, 3, 2, 2, 2, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, -1, -1,
+	-1, -1, -1, -1, -1,
+}
+
+var zstdOffsetDefault = []int{
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, -1, -1, -1, -1, -1,
+}
+
+// zstdForwardBitReader reads the FSE table descriptions from the least significant bit of each byte.
+type zstdForwardBitReader struct {
+	data   []byte
+	bitPos int
+}
+
+func (r *zstdForwardBitReader) peek(n uint) int {
+	value := 0
+	for i := 0; i < int(n); i++ {
+		pos := r.bitPos + i
+		if pos>>3 < len(r.data) {
+			value |= int((r.data[pos>>3]>>(pos&7))&1) << i
+		}
+	}
+	return value
+}
+
+func (r *zstdForwardBitReader) skip(n uint) error {
+	r.bitPos += int(n)
+	if (r.bitPos+7)>>3 > len(r.data) {
+		return errZstdCorrupt
+	}
+	return nil
+}
+
+func (r *zstdForwardBitReader) read(n uint) (int, error) {
+	value := r.peek(n)
+	return value, r.skip(n)
+}
+
+// bytesRead returns the number of bytes touched so far.
+func (r *zstdForwardBitReader) bytesRead() int {
+	return (r.bitPos + 7) >> 3
+}
+
+// zstdBackwardBitReader reads entropy coded streams, which are read from the end towards the beginning.
+type zstdBackwardBitReader struct {
+	data []byte
+	// remaining is the number of unread bits, it becomes negative when reading past the beginning.
+	remaining int
+}
+
+func newZstdBackwardBitReader(data []byte) (*zstdBackwardBitReader, error) {
+	if len(data) == 0 || data[len(data)-1] == 0 {
+		return nil, errZstdCorrupt
+	}
+	// The highest set bit of the last byte marks the beginning of the stream.
+	return &zstdBackwardBitReader{data: data, remaining: (len(data)-1)*8 + highestBit(int(data[len(data)-1]))}, nil
+}
+
+// peek returns the next n bits, the first one being the most significant. Missing bits are zeros.
+func (r *zstdBackwardBitReader) peek(n uint) int {
+	value := 0
+	for i := 1; i <= int(n); i++ {
+		value <<= 1
+		pos := r.remaining - i
+		if pos >= 0 {
+			value |= int((r.data[pos>>3] >> (pos & 7)) & 1)
+		}
+	}
+	return value
+}
+
+func (r *zstdBackwardBitReader) read(n uint) int {
+	value := r.peek(n)
+	r.remaining -= int(n)
+	return value
+}
+
+func (r *zstdBackwardBitReader) overflowed() bool {
+	return r.remaining < 0
+}
+
+// zstdFSEEntry is a state of a finite state entropy decoding table.
+type zstdFSEEntry struct {
+	symbol   int
+	numBits  uint
+	newState int
+}
+
+type zstdFSETable struct {
+	accuracyLog uint
+	entries     []zstdFSEEntry
+}
+
+// readZstdFSETable reads a table description and returns it with the number of bytes it occupied.
+func readZstdFSETable(data []byte, maxAccuracyLog uint, maxSymbol int) (*zstdFSETable, int, error) {
+	br := &zstdForwardBitReader{data: data}
+	accuracyLog, err := br.read(4)
+	if err != nil {
+		return nil, 0, err
+	}
+	accuracyLog += 5
+	if uint(accuracyLog) > maxAccuracyLog {
+		return nil, 0, errZstdCorrupt
+	}
+	remaining := (1 << accuracyLog) + 1
+	threshold := 1 << accuracyLog
+	numBits := uint(accuracyLog + 1)
+	counts := make([]int, 0, maxSymbol+1)
+	previousZero := false
+	for remaining > 1 && len(counts) <= maxSymbol {
+		if previousZero {
+			for {
+				repeat, err := br.read(2)
+				if err != nil {
+					return nil, 0, err
+				}
+				for i := 0; i < repeat; i++ {
+					counts = append(counts, 0)
+				}
+				if repeat != 3 {
+					break
+				}
+			}
+			if len(counts) > maxSymbol {
+				return nil, 0, errZstdCorrupt
+			}
+		}
+		maxValue := (2*threshold - 1) - remaining
+		bits := br.peek(numBits)
+		var count int
+		if bits&(threshold-1) < maxValue {
+			count = bits & (threshold - 1)
+			err = br.skip(numBits - 1)
+		} else {
+			count = bits & (2*threshold - 1)
+			if count >= threshold {
+				count -= maxValue
+			}
+			err = br.skip(numBits)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		count--
+		if count < 0 {
+			remaining += count
+		} else {
+			remaining -= count
+		}
+		counts = append(counts, count)
+		previousZero = count == 0
+		for remaining < threshold {
+			numBits--
+			threshold >>= 1
+		}
+	}
+	if remaining != 1 {
+		return nil, 0, errZstdCorrupt
+	}
+	table, err := buildZstdFSETable(counts, uint(accuracyLog))
+	return table, br.bytesRead(), err
+}
+
+func buildZstdFSETable(counts []int, accuracyLog uint) (*zstdFSETable, error) {
+	size := 1 << accuracyLog
+	entries := make([]zstdFSEEntry, size)
+	next := make([]int, len(counts))
+	highThreshold := size - 1
+	for symbol, count := range counts {
+		if count == -1 {
+			entries[highThreshold].symbol = symbol
+			highThreshold--
+			next[symbol] = 1
+		} else {
+			next[symbol] = count
+		}
+	}
+	position := 0
+	step := (size >> 1) + (size >> 3) + 3
+	for symbol, count := range counts {
+		for i := 0; i < count; i++ {
+			entries[position].symbol = symbol
+			position = (position + step) & (size - 1)
+			for position > highThreshold {
+				position = (position + step) & (size - 1)
+			}
+		}
+	}
+	if position != 0 {
+		return nil, errZstdCorrupt
+	}
+	for state := range entries {
+		nextState := next[entries[state].symbol]
+		next[entries[state].symbol]++
+		numBits := accuracyLog - uint(highestBit(nextState))
+		entries[state].numBits = numBits
+		entries[state].newState = (nextState << numBits) - size
+	}
+	return &zstdFSETable{accuracyLog: accuracyLog, entries: entries}, nil
+}
+
+// newZstdRLETable creates a table which always returns the same symbol without reading any bits.
+func newZstdRLETable(symbol int) *zstdFSETable {
+	return &zstdFSETable{entries: []zstdFSEEntry{{symbol: symbol}}}
+}
+
+func highestBit(value int) int {
+	bit := -1
+	for value != 0 {
+		value >>= 1
+		bit++
+	}
+	return bit
+}
+
+type zstdFSEState struct {
+	table *zstdFSETable
+	state int
+}
+
+func (s *zstdFSEState) init(br *zstdBackwardBitReader) {
+	s.state = br.read(s.table.accuracyLog)
+}
+
+func (s *zstdFSEState) symbol() int {
+	return s.table.entries[s.state].symbol
+}
+
+func (s *zstdFSEState) update(br *zstdBackwardBitReader) {
+	entry := s.table.entries[s.state]
+	s.state = entry.newState + br.read(entry.numBits)
+}
+
+// zstdHuffmanEntry is an entry of the Huffman lookup table indexed by the next maxBits bits.
+type zstdHuffmanEntry struct {
+	symbol  byte
+	numBits uint
+}
+
+type zstdHuffmanTable struct {
+	maxBits uint
+	entries []zstdHuffmanEntry
+}
+
+// readZstdHuffmanTable reads a Huffman tree description and returns it with the number of bytes it occupied.
+func readZstdHuffmanTable(data []byte) (*zstdHuffmanTable, int, error) {
+	if len(data) == 0 {
+		return nil, 0, errZstdCorrupt
+	}
+	header := int(data[0])
+	var weights []int
+	var size int
+	if header < 128 {
+		size = 1 + header
+		if size > len(data) {
+			return nil, 0, errZstdCorrupt
+		}
+		var err error
+		if weights, err = decodeZstdHuffmanWeights(data[1:size]); err != nil {
+			return nil, 0, err
+		}
+	} else {
+		count := header - 127
+		size = 1 + (count+1)/2
+		if size > len(data) {
+			return nil, 0, errZstdCorrupt
+		}
+		weights = make([]int, count)
+		for i := range weights {
+			b := data[1+i/2]
+			if i%2 == 0 {
+				weights[i] = int(b >> 4)
+			} else {
+				weights[i] = int(b & 0xf)
+			}
+		}
+	}
+	table, err := buildZstdHuffmanTable(weights)
+	return table, size, err
+}
+
+// decodeZstdHuffmanWeights decodes FSE compressed weights, which use two interleaved states.
+func decodeZstdHuffmanWeights(data []byte) ([]int, error) {
+	table, tableSize, err := readZstdFSETable(data, 6, 255)
+	if err != nil {
+		return nil, err
+	}
+	br, err := newZstdBackwardBitReader(data[tableSize:])
+	if err != nil {
+		return nil, err
+	}
+	states := [2]*zstdFSEState{{table: table}, {table: table}}
+	states[0].init(br)
+	states[1].init(br)
+	weights := make([]int, 0, 255)
+	for i := 0; ; i ^= 1 {
+		if len(weights) >= 255 {
+			return nil, errZstdCorrupt
+		}
+		weights = append(weights, states[i].symbol())
+		states[i].update(br)
+		if br.overflowed() {
+			weights = append(weights, states[i^1].symbol())
+			return weights, nil
+		}
+	}
+}
+
+func buildZstdHuffmanTable(weights []int) (*zstdHuffmanTable, error) {
+	sum := 0
+	for _, weight := range weights {
+		if weight > zstdMaxHuffmanBits {
+			return nil, errZstdCorrupt
+		}
+		if weight > 0 {
+			sum += 1 << (weight - 1)
+		}
+	}
+	if sum == 0 {
+		return nil, errZstdCorrupt
+	}
+	maxBits := uint(highestBit(sum) + 1)
+	rest := (1 << maxBits) - sum
+	if maxBits > zstdMaxHuffmanBits || rest&(rest-1) != 0 || len(weights) > 255 {
+		return nil, errZstdCorrupt
+	}
+	weights = append(weights, highestBit(rest)+1)
+
+	entries := make([]zstdHuffmanEntry, 1<<maxBits)
+	position := 0
+	for weight := 1; weight <= int(maxBits); weight++ {
+		for symbol, w := range weights {
+			if w != weight {
+				continue
+			}
+			length := 1 << (weight - 1)
+			for i := 0; i < length; i++ {
+				entries[position+i] = zstdHuffmanEntry{symbol: byte(symbol), numBits: maxBits + 1 - uint(weight)}
+			}
+			position += length
+		}
+	}
+	return &zstdHuffmanTable{maxBits: maxBits, entries: entries}, nil
+}
+
+func (t *zstdHuffmanTable) decodeStream(data []byte, size int, out []byte) ([]byte, error) {
+	br, err := newZstdBackwardBitReader(data)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < size; i++ {
+		entry := t.entries[br.peek(t.maxBits)]
+		br.remaining -= int(entry.numBits)
+		out = append(out, entry.symbol)
+	}
+	if br.remaining != 0 {
+		return nil, errZstdCorrupt
+	}
+	return out, nil
+}
+
+// zstdDecoder holds the state that lives across blocks of a frame.
+type zstdDecoder struct {
+	out           []byte
+	limit         int
+	frameStart    int
+	huffman       *zstdHuffmanTable
+	literalTable  *zstdFSETable
+	offsetTable   *zstdFSETable
+	matchTable    *zstdFSETable
+	repeatOffsets [3]int
+}
+
+// decodeZstd decompresses zstd frames.
+// With a positive limit, the output is cut at limit bytes and errDecodedBodyTooLarge is returned.
+func decodeZstd(data []byte, limit int) ([]byte, error) {
+	d := &zstdDecoder{limit: limit}
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return d.out, errZstdCorrupt
+		}
+		magic := binary.LittleEndian.Uint32(data)
+		if magic&zstdSkippableMagicMask == zstdSkippableMagicNumber {
+			if len(data) < 8 {
+				return d.out, errZstdCorrupt
+			}
+			size := int(binary.LittleEndian.Uint32(data[4:]))
+			if size > len(data)-8 {
+				return d.out, errZstdCorrupt
+			}
+			data = data[8+size:]
+			continue
+		}
+		if magic != zstdMagicNumber {
+			return d.out, errZstdCorrupt
+		}
+		size, err := d.readFrame(data[4:])
+		if err != nil {
+			return d.out, err
+		}
+		data = data[4+size:]
+	}
+	return d.out, nil
+}
+
+// readFrame decodes a frame after the magic number and returns the number of bytes it occupied.
+func (d *zstdDecoder) readFrame(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, errZstdCorrupt
+	}
+	descriptor := data[0]
+	contentSizeFlag := descriptor >> 6
+	singleSegment := descriptor&0x20 != 0
+	hasChecksum := descriptor&0x04 != 0
+	dictionaryIDFlag := descriptor & 0x03
+	if descriptor&0x08 != 0 {
+		return 0, errZstdCorrupt
+	}
+	pos := 1
+	if !singleSegment {
+		pos++ // Window descriptor, the whole output is kept anyway.
+	}
+	dictionaryIDSize := [4]int{0, 1, 2, 4}[dictionaryIDFlag]
+	if pos+dictionaryIDSize > len(data) {
+		return 0, errZstdCorrupt
+	}
+	for i := 0; i < dictionaryIDSize; i++ {
+		if data[pos+i] != 0 {
+			return 0, errZstdDictionary
+		}
+	}
+	pos += dictionaryIDSize
+	contentSizeSize := [4]int{0, 2, 4, 8}[contentSizeFlag]
+	if contentSizeFlag == 0 && singleSegment {
+		contentSizeSize = 1
+	}
+	pos += contentSizeSize
+	if pos > len(data) {
+		return 0, errZstdCorrupt
+	}
+
+	d.frameStart = len(d.out)
+	d.huffman, d.literalTable, d.offsetTable, d.matchTable = nil, nil, nil, nil
+	d.repeatOffsets = [3]int{1, 4, 8}
+	for {
+		if pos+3 > len(data) {
+			return 0, errZstdCorrupt
+		}
+		header := int(data[pos]) | int(data[pos+1])<<8 | int(data[pos+2])<<16
+		pos += 3
+		last := header&1 != 0
+		blockType := (header >> 1) & 3
+		blockSize := header >> 3
+		var err error
+		switch blockType {
+		case 0: // Raw
+			if pos+blockSize > len(data) {
+				return 0, errZstdCorrupt
+			}
+			err = d.write(data[pos : pos+blockSize])
+			pos += blockSize
+		case 1: // RLE
+			if pos >= len(data) {
+				return 0, errZstdCorrupt
+			}
+			var n int
+			n, err = d.fit(blockSize)
+			for i := 0; i < n; i++ {
+				d.out = append(d.out, data[pos])
+			}
+			pos++
+		case 2: // Compressed
+			if pos+blockSize > len(data) {
+				return 0, errZstdCorrupt
+			}
+			err = d.readCompressedBlock(data[pos : pos+blockSize])
+			pos += blockSize
+		default:
+			err = errZstdCorrupt
+		}
+		if err != nil {
+			return 0, err
+		}
+		if last {
+			break
+		}
+	}
+	if hasChecksum {
+		pos += 4
+		if pos > len(data) {
+			return 0, errZstdCorrupt
+		}
+	}
+	return pos, nil
+}
+
+func (d *zstdDecoder) readCompressedBlock(data []byte) error {
+	literals, size, err := d.readLiterals(data)
+	if err != nil {
+		return err
+	}
+	return d.readSequences(data[size:], literals)
+}
+
+// readLiterals decodes the literals section and returns the literals with the size of the section.
+func (d *zstdDecoder) readLiterals(data []byte) ([]byte, int, error) {
+	if len(data) == 0 {
+		return nil, 0, errZstdCorrupt
+	}
+	literalsType := data[0] & 3
+	sizeFormat := (data[0] >> 2) & 3
+	if literalsType < 2 {
+		var regeneratedSize, headerSize int
+		switch sizeFormat {
+		case 0, 2:
+			regeneratedSize, headerSize = int(data[0]>>3), 1
+		case 1:
+			if len(data) < 2 {
+				return nil, 0, errZstdCorrupt
+			}
+			regeneratedSize, headerSize = int(data[0]>>4)|int(data[1])<<4, 2
+		default:
+			if len(data) < 3 {
+				return nil, 0, errZstdCorrupt
+			}
+			regeneratedSize, headerSize = int(data[0]>>4)|int(data[1])<<4|int(data[2])<<12, 3
+		}
+		if literalsType == 0 {
+			if headerSize+regeneratedSize > len(data) {
+				return nil, 0, errZstdCorrupt
+			}
+			return data[headerSize : headerSize+regeneratedSize], headerSize + regeneratedSize, nil
+		}
+		if headerSize >= len(data) {
+			return nil, 0, errZstdCorrupt
+		}
+		literals := make([]byte, regeneratedSize)
+		for i := range literals {
+			literals[i] = data[headerSize]
+		}
+		return literals, headerSize + 1, nil
+	}
+	return d.readHuffmanLiterals(data, literalsType == 3, sizeFormat)
+}
+
+func (d *zstdDecoder) readHuffmanLiterals(data []byte, treeless bool, sizeFormat byte) ([]byte, int, error) {
+	headerSize := [4]int{3, 3, 4, 5}[sizeFormat]
+	if len(data) < headerSize {
+		return nil, 0, errZstdCorrupt
+	}
+	header := 0
+	for i := headerSize - 1; i >= 0; i-- {
+		header = header<<8 | int(data[i])
+	}
+	fieldBits := [4]uint{10, 10, 14, 18}[sizeFormat]
+	regeneratedSize := (header >> 4) & (1<<fieldBits - 1)
+	compressedSize := (header >> (4 + fieldBits)) & (1<<fieldBits - 1)
+	if headerSize+compressedSize > len(data) {
+		return nil, 0, errZstdCorrupt
+	}
+	compressed := data[headerSize : headerSize+compressedSize]
+	if !treeless {
+		table, size, err := readZstdHuffmanTable(compressed)
+		if err != nil {
+			return nil, 0, err
+		}
+		d.huffman = table
+		compressed = compressed[size:]
+	}
+	if d.huffman == nil {
+		return nil, 0, errZstdCorrupt
+	}
+	literals := make([]byte, 0, regeneratedSize)
+	var err error
+	if sizeFormat == 0 {
+		literals, err = d.huffman.decodeStream(compressed, regeneratedSize, literals)
+		return literals, headerSize + compressedSize, err
+	}
+	if len(compressed) < 6 {
+		return nil, 0, errZstdCorrupt
+	}
+	streamSizes := [4]int{
+		int(binary.LittleEndian.Uint16(compressed[0:])),
+		int(binary.LittleEndian.Uint16(compressed[2:])),
+		int(binary.LittleEndian.Uint16(compressed[4:])),
+	}
+	streamSizes[3] = len(compressed) - 6 - streamSizes[0] - streamSizes[1] - streamSizes[2]
+	if streamSizes[3] < 0 {
+		return nil, 0, errZstdCorrupt
+	}
+	segmentSize := (regeneratedSize + 3) / 4
+	if 3*segmentSize > regeneratedSize {
+		return nil, 0, errZstdCorrupt
+	}
+	start := 6
+	for i, streamSize := range streamSizes {
+		size := segmentSize
+		if i == 3 {
+			size = regeneratedSize - 3*segmentSize
+		}
+		if literals, err = d.huffman.decodeStream(compressed[start:start+streamSize], size, literals); err != nil {
+			return nil, 0, err
+		}
+		start += streamSize
+	}
+	return literals, headerSize + compressedSize, nil
+}
+
+func (d *zstdDecoder) readSequences(data []byte, literals []byte) error {
+	if len(data) == 0 {
+		return errZstdCorrupt
+	}
+	numSequences := int(data[0])
+	pos := 1
+	switch {
+	case numSequences == 0:
+		return d.write(literals)
+	case numSequences == 255:
+		if len(data) < 3 {
+			return errZstdCorrupt
+		}
+		numSequences = int(data[1]) + int(data[2])<<8 + 0x7F00
+		pos = 3
+	case numSequences >= 128:
+		if len(data) < 2 {
+			return errZstdCorrupt
+		}
+		numSequences = (numSequences-128)<<8 + int(data[1])
+		pos = 2
+	}
+	if pos >= len(data) {
+		return errZstdCorrupt
+	}
+	modes := data[pos]
+	pos++
+	var err error
+	var size int
+	if d.literalTable, size, err = readZstdSequenceTable(data[pos:], modes>>6, d.literalTable, zstdLiteralLengthDefault, 6, 9, 35); err != nil {
+		return err
+	}
+	pos += size
+	if d.offsetTable, size, err = readZstdSequenceTable(data[pos:], (modes>>4)&3, d.offsetTable, zstdOffsetDefault, 5, 8, 31); err != nil {
+		return err
+	}
+	pos += size
+	if d.matchTable, size, err = readZstdSequenceTable(data[pos:], (modes>>2)&3, d.matchTable, zstdMatchLengthDefault, 6, 9, 52); err != nil {
+		return err
+	}
+	pos += size
+	br, err := newZstdBackwardBitReader(data[pos:])
+	if err != nil {
+		return err
+	}
+	return d.executeSequences(br, numSequences, literals)
+}
+
+// readZstdSequenceTable reads the table of a sequence symbol type according to its compression mode.
+func readZstdSequenceTable(data []byte, mode byte, previous *zstdFSETable, defaults []int, defaultLog, maxLog uint, maxSymbol int) (*zstdFSETable, int, error) {
+	switch mode {
+	case 0: // Predefined
+		table, err := buildZstdFSETable(defaults, defaultLog)
+		return table, 0, err
+	case 1: // RLE
+		if len(data) == 0 || int(data[0]) > maxSymbol {
+			return nil, 0, errZstdCorrupt
+		}
+		return newZstdRLETable(int(data[0])), 1, nil
+	case 2: // FSE compressed
+		return readZstdFSETable(data, maxLog, maxSymbol)
+	default: // Repeat
+		if previous == nil {
+			return nil, 0, errZstdCorrupt
+		}
+		return previous, 0, nil
+	}
+}
+
+func (d *zstdDecoder) executeSequences(br *zstdBackwardBitReader, numSequences int, literals []byte) error {
+	literalState := &zstdFSEState{table: d.literalTable}
+	offsetState := &zstdFSEState{table: d.offsetTable}
+	matchState := &zstdFSEState{table: d.matchTable}
+	literalState.init(br)
+	offsetState.init(br)
+	matchState.init(br)
+	for i := 0; i < numSequences; i++ {
+		offsetCode := offsetState.symbol()
+		matchCode := matchState.symbol()
+		literalCode := literalState.symbol()
+		if offsetCode > 31 {
+			return errZstdCorrupt
+		}
+		offsetValue := (1 << offsetCode) + br.read(uint(offsetCode))
+		matchLength := zstdMatchLengthBase[matchCode] + br.read(zstdMatchLengthBits[matchCode])
+		literalLength := zstdLiteralLengthBase[literalCode] + br.read(zstdLiteralLengthBits[literalCode])
+		if i < numSequences-1 {
+			literalState.update(br)
+			matchState.update(br)
+			offsetState.update(br)
+		}
+		if br.overflowed() || literalLength > len(literals) {
+			return errZstdCorrupt
+		}
+		if err := d.write(literals[:literalLength]); err != nil {
+			return err
+		}
+		literals = literals[literalLength:]
+		offset := d.resolveOffset(offsetValue, literalLength)
+		if offset <= 0 || offset > len(d.out)-d.frameStart {
+			return errZstdCorrupt
+		}
+		start := len(d.out) - offset
+		n, err := d.fit(matchLength)
+		for j := 0; j < n; j++ {
+			d.out = append(d.out, d.out[start+j])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if br.remaining != 0 {
+		return errZstdCorrupt
+	}
+	return d.write(literals)
+}
+
+// fit returns how many of n more output bytes are allowed by the limit.
+// It returns errDecodedBodyTooLarge when that is fewer than n.
+func (d *zstdDecoder) fit(n int) (int, error) {
+	if d.limit <= 0 || len(d.out)+n <= d.limit {
+		return n, nil
+	}
+	return d.limit - len(d.out), errDecodedBodyTooLarge
+}
+
+func (d *zstdDecoder) write(b []byte) error {
+	n, err := d.fit(len(b))
+	d.out = append(d.out, b[:n]...)
+	return err
+}
+
+// resolveOffset converts an offset value to an actual offset while maintaining the repeated offsets.
+func (d *zstdDecoder) resolveOffset(offsetValue, literalLength int) int {
+	if offsetValue > 3 {
+		offset := offsetValue - 3
+		d.repeatOffsets = [3]int{offset, d.repeatOffsets[0], d.repeatOffsets[1]}
+		return offset
+	}
+	index := offsetValue
+	if literalLength == 0 {
+		index++
+	}
+	var offset int
+	switch index {
+	case 1:
+		return d.repeatOffsets[0]
+	case 2:
+		offset = d.repeatOffsets[1]
+		d.repeatOffsets[1] = d.repeatOffsets[0]
+	case 3:
+		offset = d.repeatOffsets[2]
+		d.repeatOffsets[2] = d.repeatOffsets[1]
+		d.repeatOffsets[1] = d.repeatOffsets[0]
+	default:
+		offset = d.repeatOffsets[0] - 1
+		d.repeatOffsets[2] = d.repeatOffsets[1]
+		d.repeatOffsets[1] = d.repeatOffsets[0]
+	}
+	d.repeatOffsets[0] = offset
+	return offset
+}